@@ -0,0 +1,171 @@
+package requests
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultAltSvcMaxAge is the entry lifetime when the header carries no ma
+// parameter, per RFC 7838.
+const defaultAltSvcMaxAge = 24 * time.Hour
+
+// altSvcEntry is one alternative service advertised for an origin.
+type altSvcEntry struct {
+	protocol  string // ALPN protocol id, e.g. "h2"
+	authority string // alternative host:port; host may be empty for same-host
+	expires   time.Time
+}
+
+// altSvcCache stores alternative services keyed by origin (scheme://host).
+type altSvcCache struct {
+	mu      sync.RWMutex
+	entries map[string]altSvcEntry
+}
+
+// SetAltSvc opts in to honoring Alt-Svc response headers: subsequent
+// requests to an origin that advertised an alternative service connect to
+// the advertised endpoint instead, for as long as its ma lifetime lasts. The
+// origin's URL and Host header are preserved, only the connection target
+// changes. Protocols the transport cannot negotiate (e.g. h3) are ignored,
+// and only same-host alternatives (port changes) are honored: RFC 7838
+// requires validating the certificate for the origin, and the standard
+// transport validates the dialed host instead, so a cross-host alternative
+// would let a spoofed header redirect credentialed traffic to any host with
+// a valid certificate for itself.
+func (c *Client) SetAltSvc(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if enabled {
+		if c.altSvc == nil {
+			c.altSvc = &altSvcCache{entries: make(map[string]altSvcEntry)}
+		}
+	} else {
+		c.altSvc = nil
+	}
+	return c
+}
+
+// getAltSvcCache returns the Alt-Svc cache, or nil when disabled.
+func (c *Client) getAltSvcCache() *altSvcCache {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.altSvc
+}
+
+// recordAltSvc stores the alternatives a response advertises for its origin.
+func (c *Client) recordAltSvc(origin *url.URL, header http.Header) {
+	cache := c.getAltSvcCache()
+	if cache == nil {
+		return
+	}
+
+	value := header.Get("Alt-Svc")
+	if value == "" {
+		return
+	}
+	key := origin.Scheme + "://" + origin.Host
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if strings.TrimSpace(value) == "clear" {
+		delete(cache.entries, key)
+		return
+	}
+	for _, entry := range parseAltSvc(value) {
+		// Only keep alternatives the standard transport can actually speak.
+		if entry.protocol != "h2" && entry.protocol != "http/1.1" {
+			continue
+		}
+		cache.entries[key] = entry
+		return
+	}
+}
+
+// altSvcFor returns the alternative authority for the origin, or "" when
+// none is known or the entry has expired.
+func (c *Client) altSvcFor(origin *url.URL) string {
+	cache := c.getAltSvcCache()
+	if cache == nil {
+		return ""
+	}
+	key := origin.Scheme + "://" + origin.Host
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	entry, ok := cache.entries[key]
+	if !ok {
+		return ""
+	}
+	if time.Now().After(entry.expires) {
+		delete(cache.entries, key)
+		return ""
+	}
+
+	authority := entry.authority
+	if strings.HasPrefix(authority, ":") {
+		// Same host, different port.
+		return origin.Hostname() + authority
+	}
+	// Reject cross-host alternatives: TLS would validate the alternative's
+	// certificate rather than the origin's, so honoring them would redirect
+	// the origin's credentials to whatever host the header names.
+	host := authority
+	if h, _, err := net.SplitHostPort(authority); err == nil {
+		host = h
+	}
+	if !strings.EqualFold(host, origin.Hostname()) {
+		return ""
+	}
+	return authority
+}
+
+// applyAltSvc redirects the request to the origin's alternative service,
+// keeping the origin URL authority in the Host header.
+func (c *Client) applyAltSvc(req *http.Request) {
+	alt := c.altSvcFor(req.URL)
+	if alt == "" || alt == req.URL.Host {
+		return
+	}
+	if req.Host == "" {
+		req.Host = req.URL.Host
+	}
+	req.URL.Host = alt
+}
+
+// parseAltSvc parses an Alt-Svc header value: comma-separated alternatives,
+// each `protocol="authority"` with optional semicolon-separated parameters.
+func parseAltSvc(value string) []altSvcEntry {
+	var entries []altSvcEntry
+	for _, alternative := range strings.Split(value, ",") {
+		parts := strings.Split(alternative, ";")
+		protocol, authority, found := strings.Cut(strings.TrimSpace(parts[0]), "=")
+		if !found {
+			continue
+		}
+
+		entry := altSvcEntry{
+			protocol:  protocol,
+			authority: strings.Trim(authority, `"`),
+			expires:   time.Now().Add(defaultAltSvcMaxAge),
+		}
+		for _, param := range parts[1:] {
+			key, paramValue, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if key == "ma" {
+				if seconds, err := strconv.Atoi(strings.Trim(paramValue, `"`)); err == nil {
+					entry.expires = time.Now().Add(time.Duration(seconds) * time.Second)
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
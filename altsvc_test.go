@@ -0,0 +1,107 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAltSvc(t *testing.T) {
+	entries := parseAltSvc(`h3=":443"; ma=3600, h2="alt.example.com:443"; ma=60`)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "h3", entries[0].protocol)
+	assert.Equal(t, ":443", entries[0].authority)
+	assert.Equal(t, "h2", entries[1].protocol)
+	assert.Equal(t, "alt.example.com:443", entries[1].authority)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), entries[1].expires, 5*time.Second)
+}
+
+func TestAltSvcSwitchesEndpoint(t *testing.T) {
+	var altHost string
+	altServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		altHost = r.Host
+		_, _ = w.Write([]byte("alternative"))
+	}))
+	defer altServer.Close()
+	altAuthority := strings.TrimPrefix(altServer.URL, "http://")
+
+	originServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Alt-Svc", fmt.Sprintf(`http/1.1=%q; ma=60`, altAuthority))
+		_, _ = w.Write([]byte("origin"))
+	}))
+	defer originServer.Close()
+
+	client := Create(&Config{BaseURL: originServer.URL})
+	client.SetAltSvc(true)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "origin", resp.String())
+	_ = resp.Close()
+
+	resp, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, "alternative", resp.String())
+	originAuthority := strings.TrimPrefix(originServer.URL, "http://")
+	assert.Equal(t, originAuthority, altHost, "the origin authority rides the Host header")
+}
+
+func TestAltSvcIgnoredWhenDisabled(t *testing.T) {
+	var calls int
+	originServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Alt-Svc", `http/1.1="127.0.0.1:1"; ma=60`)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer originServer.Close()
+
+	client := Create(&Config{BaseURL: originServer.URL})
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+		_ = resp.Close()
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	t.Helper()
+	parsed, err := url.Parse(raw)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestAltSvcClearForgetsOrigin(t *testing.T) {
+	client := Create(nil)
+	client.SetAltSvc(true)
+
+	origin := mustParseURL(t, "https://api.example.com")
+	header := http.Header{"Alt-Svc": []string{`h2="api.example.com:8443"; ma=60`}}
+	client.recordAltSvc(origin, header)
+	require.Equal(t, "api.example.com:8443", client.altSvcFor(origin))
+
+	client.recordAltSvc(origin, http.Header{"Alt-Svc": []string{"clear"}})
+	assert.Empty(t, client.altSvcFor(origin))
+}
+
+func TestAltSvcRejectsCrossHostAlternative(t *testing.T) {
+	client := Create(nil)
+	client.SetAltSvc(true)
+
+	origin := mustParseURL(t, "https://api.example.com")
+	header := http.Header{"Alt-Svc": []string{`h2="evil.example.com:443"; ma=60`}}
+	client.recordAltSvc(origin, header)
+	assert.Empty(t, client.altSvcFor(origin), "cross-host alternatives must not be honored")
+}
@@ -0,0 +1,121 @@
+package requests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// artifactBodyLimit caps how many body bytes are written into an error
+// artifact, so dumps stay small enough to attach to incident reports.
+const artifactBodyLimit = 4096
+
+// SetErrorArtifactDir configures a directory that receives a redacted dump of
+// every request that ultimately fails — after retries, or when a problem
+// details response is surfaced as an error. Each dump is a timestamped file
+// holding the request line, headers, truncated bodies, attempt count, and
+// timings, so incidents can be diagnosed without enabling verbose logging
+// globally. An empty dir disables dumps.
+func (c *Client) SetErrorArtifactDir(dir string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.errorArtifactDir = dir
+	return c
+}
+
+// getErrorArtifactDir returns the error artifact directory under the read lock.
+func (c *Client) getErrorArtifactDir() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.errorArtifactDir
+}
+
+// writeErrorArtifact writes a redacted failure dump for the request into the
+// configured artifact directory. Dump failures are logged, never surfaced, so
+// diagnostics cannot turn a failed request into a different error.
+func (c *Client) writeErrorArtifact(req *http.Request, statusCode int, respHeader http.Header, respBody []byte, attempts int, delayTotal, duration time.Duration, cause error) {
+	dir := c.getErrorArtifactDir()
+	if dir == "" || req == nil {
+		return
+	}
+
+	redactor := c.getRedactor()
+	if redactor == nil {
+		redactor = NewDefaultRedactor()
+	}
+
+	var dump strings.Builder
+	fmt.Fprintf(&dump, "%s %s\n", req.Method, redactor.RedactURL(req.URL))
+	writeArtifactHeaders(&dump, redactor.RedactHeaders(req.Header))
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(io.LimitReader(body, artifactBodyLimit))
+			_ = body.Close()
+			writeArtifactBody(&dump, "request body", redactor.RedactBody(req.Header.Get("Content-Type"), data))
+		}
+	}
+
+	if tags := TagsFromContext(req.Context()); len(tags) > 0 {
+		dump.WriteString("\n")
+		writeArtifactTags(&dump, tags)
+	}
+
+	fmt.Fprintf(&dump, "\nerror: %v\n", cause)
+	fmt.Fprintf(&dump, "attempts: %d\n", attempts)
+	fmt.Fprintf(&dump, "duration: %s\n", duration)
+	fmt.Fprintf(&dump, "retry delay total: %s\n", delayTotal)
+
+	if statusCode != 0 {
+		fmt.Fprintf(&dump, "\nresponse: %d %s\n", statusCode, http.StatusText(statusCode))
+		writeArtifactHeaders(&dump, redactor.RedactHeaders(respHeader))
+		if len(respBody) > artifactBodyLimit {
+			respBody = respBody[:artifactBodyLimit]
+		}
+		writeArtifactBody(&dump, "response body", redactor.RedactBody(respHeader.Get("Content-Type"), respBody))
+	}
+
+	timestamp := time.Now().UTC().Format("20060102T150405.000000000")
+	file, err := os.CreateTemp(dir, "requests-error-"+timestamp+"-*.log")
+	if err != nil {
+		if logger := c.getLogger(); logger != nil {
+			logger.Errorf("Error creating error artifact: %v", err)
+		}
+		return
+	}
+	defer file.Close() //nolint:errcheck
+
+	if _, err := file.WriteString(dump.String()); err != nil {
+		if logger := c.getLogger(); logger != nil {
+			logger.Errorf("Error writing error artifact: %v", err)
+		}
+	}
+}
+
+// writeArtifactHeaders writes headers in sorted order for stable dumps.
+func writeArtifactHeaders(dump *strings.Builder, header http.Header) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Fprintf(dump, "%s: %s\n", key, value)
+		}
+	}
+}
+
+// writeArtifactBody writes a truncated body section when there is one.
+func writeArtifactBody(dump *strings.Builder, label string, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	fmt.Fprintf(dump, "\n-- %s (first %d bytes) --\n%s\n", label, artifactBodyLimit, body)
+}
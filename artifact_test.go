@@ -0,0 +1,91 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readSingleArtifact asserts exactly one dump exists in dir and returns it.
+func readSingleArtifact(t *testing.T, dir string) string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "expected exactly one error artifact")
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestErrorArtifactWrittenAfterRetriesExhausted(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	mockServer.Close() // Force connection failures.
+
+	dir := t.TempDir()
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 1})
+	client.SetRetryStrategy(DefaultBackoffStrategy(0))
+	client.SetErrorArtifactDir(dir)
+
+	_, err := client.Get("/incident").
+		Header("Authorization", "Bearer secret").
+		Send(context.Background())
+	require.Error(t, err)
+
+	dump := readSingleArtifact(t, dir)
+	assert.Contains(t, dump, "GET "+mockServer.URL+"/incident")
+	assert.Contains(t, dump, "attempts: 2")
+	assert.Contains(t, dump, "error:")
+	assert.Contains(t, dump, "Authorization: "+redactedPlaceholder, "credentials must be redacted")
+	assert.NotContains(t, dump, "Bearer secret")
+}
+
+func TestErrorArtifactWrittenOnProblemFailure(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"type":"https://example.com/denied","title":"Denied","status":403}`))
+	}))
+	defer mockServer.Close()
+
+	dir := t.TempDir()
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetFailOnProblem(true)
+	client.SetErrorArtifactDir(dir)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.Error(t, err)
+	if resp != nil {
+		defer resp.Close() //nolint:errcheck
+	}
+
+	dump := readSingleArtifact(t, dir)
+	assert.Contains(t, dump, "response: 403 Forbidden")
+	assert.Contains(t, dump, "Denied")
+}
+
+func TestNoArtifactOnSuccess(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	dir := t.TempDir()
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetErrorArtifactDir(dir)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
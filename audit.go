@@ -0,0 +1,191 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditRecord is one line of the outbound request audit log, written as JSON
+// per completed call. It captures what compliance reviews typically ask for:
+// who was called, when, with what outcome, and how expensive the call was.
+type AuditRecord struct {
+	Time       time.Time         `json:"time"`
+	Method     string            `json:"method"`
+	URL        string            `json:"url"`
+	Status     int               `json:"status"`
+	DurationMS float64           `json:"duration_ms"`
+	Bytes      int64             `json:"bytes"`
+	Attempts   int               `json:"attempts"`
+	Error      string            `json:"error,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+}
+
+// auditLog serializes writes of JSONL records to a writer or rotating file.
+type auditLog struct {
+	mu       sync.Mutex
+	writer   io.Writer
+	path     string
+	maxBytes int64
+	keep     int
+	file     *os.File
+	size     int64
+	enabled  bool
+}
+
+// SetAuditWriter enables the audit log, appending one JSON line per request
+// to w. URLs are redacted with the client's redactor before being written.
+// Passing nil disables auditing and drops any configured destination.
+func (c *Client) SetAuditWriter(w io.Writer) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if w == nil {
+		c.audit = nil
+		return c
+	}
+	c.audit = &auditLog{writer: w, enabled: true}
+	return c
+}
+
+// SetAuditFile enables the audit log backed by a file that rotates once it
+// exceeds maxBytes, keeping up to keep rotated copies (path.1 is the newest).
+// A maxBytes of zero or less disables rotation.
+func (c *Client) SetAuditFile(path string, maxBytes int64, keep int) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.audit = &auditLog{path: path, maxBytes: maxBytes, keep: keep, file: file, size: info.Size(), enabled: true}
+	c.closeHooks = append(c.closeHooks, func() { _ = file.Close() })
+	return nil
+}
+
+// SetAuditEnabled pauses or resumes auditing at runtime without tearing down
+// the configured destination. It is a no-op when no destination is set.
+func (c *Client) SetAuditEnabled(enabled bool) *Client {
+	c.mu.RLock()
+	audit := c.audit
+	c.mu.RUnlock()
+
+	if audit != nil {
+		audit.mu.Lock()
+		audit.enabled = enabled
+		audit.mu.Unlock()
+	}
+	return c
+}
+
+// getAuditLog returns the audit destination under the read lock.
+func (c *Client) getAuditLog() *auditLog {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.audit
+}
+
+// writeAudit appends one record for a finished call. Audit failures are
+// reported to the client logger rather than failing the request.
+func (c *Client) writeAudit(requestURL *url.URL, method string, status int, attempts int, duration time.Duration, bytes int64, callErr error, tags map[string]string) {
+	audit := c.getAuditLog()
+	if audit == nil {
+		return
+	}
+
+	record := AuditRecord{
+		Time:       time.Now().UTC(),
+		Method:     method,
+		URL:        c.redactURLForLog(requestURL),
+		Status:     status,
+		DurationMS: float64(duration) / float64(time.Millisecond),
+		Bytes:      bytes,
+		Attempts:   attempts,
+		Tags:       tags,
+	}
+	if callErr != nil {
+		record.Error = callErr.Error()
+	}
+
+	if err := audit.write(&record); err != nil {
+		if logger := c.getLogger(); logger != nil {
+			logger.Errorf("Error writing audit record: %v", err)
+		}
+	}
+}
+
+// write marshals and appends one record, rotating the backing file first if
+// the next line would push it past maxBytes.
+func (a *auditLog) write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.enabled {
+		return nil
+	}
+
+	if a.file != nil {
+		if a.maxBytes > 0 && a.size+int64(len(line)) > a.maxBytes && a.size > 0 {
+			if err := a.rotateLocked(); err != nil {
+				return err
+			}
+		}
+		n, err := a.file.Write(line)
+		a.size += int64(n)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+		}
+		return nil
+	}
+
+	if _, err := a.writer.Write(line); err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+	}
+	return nil
+}
+
+// rotateLocked shifts path.N to path.N+1, moves the live file to path.1, and
+// reopens a fresh file at path. Called with the audit mutex held.
+func (a *auditLog) rotateLocked() error {
+	if err := a.file.Close(); err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+	}
+
+	keep := a.keep
+	if keep < 1 {
+		keep = 1
+	}
+	_ = os.Remove(fmt.Sprintf("%s.%d", a.path, keep))
+	for i := keep - 1; i >= 1; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", a.path, i), fmt.Sprintf("%s.%d", a.path, i+1))
+	}
+	if err := os.Rename(a.path, a.path+".1"); err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+	}
+
+	file, err := os.OpenFile(a.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditLogFailed, err) //nolint:errorlint
+	}
+	a.file = file
+	a.size = 0
+	return nil
+}
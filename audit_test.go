@@ -0,0 +1,102 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditWriterRecordsRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetAuditWriter(&buf)
+
+	_, err := client.Get("/users").Tag("caller", "billing").Send(context.Background())
+	require.NoError(t, err)
+
+	var record AuditRecord
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, http.MethodGet, record.Method)
+	assert.Equal(t, server.URL+"/users", record.URL)
+	assert.Equal(t, http.StatusOK, record.Status)
+	assert.Equal(t, int64(5), record.Bytes)
+	assert.Equal(t, 1, record.Attempts)
+	assert.Equal(t, "billing", record.Tags["caller"])
+	assert.False(t, record.Time.IsZero())
+}
+
+func TestAuditRecordsFailedRequests(t *testing.T) {
+	var buf bytes.Buffer
+	client := Create(&Config{BaseURL: "http://127.0.0.1:0"})
+	client.SetAuditWriter(&buf)
+
+	_, err := client.Get("/").Send(context.Background())
+	require.Error(t, err)
+
+	var record AuditRecord
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, 0, record.Status)
+	assert.NotEmpty(t, record.Error)
+}
+
+func TestAuditToggleAtRuntime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetAuditWriter(&buf)
+	client.SetAuditEnabled(false)
+
+	_, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, buf.Len())
+
+	client.SetAuditEnabled(true)
+	_, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Positive(t, buf.Len())
+}
+
+func TestAuditFileRotation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	client := Create(&Config{BaseURL: server.URL})
+	require.NoError(t, client.SetAuditFile(path, 200, 2))
+
+	for i := 0; i < 6; i++ {
+		_, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+	}
+
+	rotated, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	assert.Positive(t, len(rotated))
+
+	live, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, line := range strings.Split(strings.TrimSpace(string(rotated)+string(live)), "\n") {
+		var record AuditRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+	}
+}
@@ -41,6 +41,74 @@ func (b BearerAuth) Valid() bool {
 	return b.Token != ""
 }
 
+// multiAuth applies several auth methods to the same request.
+type multiAuth []AuthMethod
+
+// MultiAuth combines multiple auth methods into one that applies each of
+// them in order, for gateways that require e.g. both a tenant API key and a
+// user bearer token.
+func MultiAuth(methods ...AuthMethod) AuthMethod {
+	return multiAuth(methods)
+}
+
+// Apply applies every valid method in order; later methods win on conflicts.
+func (m multiAuth) Apply(req *http.Request) {
+	for _, method := range m {
+		if method != nil && method.Valid() {
+			method.Apply(req)
+		}
+	}
+}
+
+// Valid checks if at least one composed method is valid.
+func (m multiAuth) Valid() bool {
+	for _, method := range m {
+		if method != nil && method.Valid() {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyLocation determines where APIKeyAuth places the key.
+type APIKeyLocation int
+
+const (
+	// APIKeyInHeader places the key in a request header (the default).
+	APIKeyInHeader APIKeyLocation = iota
+	// APIKeyInQuery places the key in the URL query string.
+	APIKeyInQuery
+)
+
+// APIKeyAuth represents an API key credential placed in a header or query
+// parameter, e.g. APIKeyAuth{Key: "X-API-Key", Value: secret} or
+// APIKeyAuth{Key: "api_key", Value: secret, In: APIKeyInQuery}.
+type APIKeyAuth struct {
+	Key   string
+	Value string
+	In    APIKeyLocation
+}
+
+// Apply adds the API key to the request at the configured location.
+func (a APIKeyAuth) Apply(req *http.Request) {
+	if !a.Valid() {
+		return
+	}
+	switch a.In {
+	case APIKeyInQuery:
+		query := req.URL.Query()
+		query.Set(a.Key, a.Value)
+		req.URL.RawQuery = query.Encode()
+	default:
+		req.Header.Set(a.Key, a.Value)
+	}
+}
+
+// Valid checks if both the key name and value are present.
+func (a APIKeyAuth) Valid() bool {
+	return a.Key != "" && a.Value != ""
+}
+
 // CustomAuth allows for custom Authorization header values.
 type CustomAuth struct {
 	Header string
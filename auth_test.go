@@ -0,0 +1,122 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyAuthHeader(t *testing.T) {
+	auth := APIKeyAuth{Key: "X-API-Key", Value: "secret"}
+	require.True(t, auth.Valid())
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Equal(t, "secret", req.Header.Get("X-API-Key"))
+}
+
+func TestAPIKeyAuthQuery(t *testing.T) {
+	auth := APIKeyAuth{Key: "api_key", Value: "secret", In: APIKeyInQuery}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?page=1", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Equal(t, "secret", req.URL.Query().Get("api_key"))
+	assert.Equal(t, "1", req.URL.Query().Get("page"), "existing query params are preserved")
+}
+
+func TestAPIKeyAuthInvalid(t *testing.T) {
+	auth := APIKeyAuth{Key: "X-API-Key"}
+	assert.False(t, auth.Valid())
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Empty(t, req.Header.Get("X-API-Key"))
+}
+
+func TestMultiAuthAppliesAllMethods(t *testing.T) {
+	auth := MultiAuth(
+		BearerAuth{Token: "user-token"},
+		APIKeyAuth{Key: "X-Tenant-Key", Value: "tenant-secret"},
+	)
+	require.True(t, auth.Valid())
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Equal(t, "Bearer user-token", req.Header.Get("Authorization"))
+	assert.Equal(t, "tenant-secret", req.Header.Get("X-Tenant-Key"))
+}
+
+func TestMultiAuthSkipsInvalidMethods(t *testing.T) {
+	auth := MultiAuth(BearerAuth{}, APIKeyAuth{Key: "X-API-Key", Value: "secret"})
+	require.True(t, auth.Valid())
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Empty(t, req.Header.Get("Authorization"))
+	assert.Equal(t, "secret", req.Header.Get("X-API-Key"))
+
+	assert.False(t, MultiAuth(BearerAuth{}).Valid())
+}
+
+func TestSetHostAuthSelectsByHost(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer mockServer.Close()
+
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetAuth(BearerAuth{Token: "default"})
+	client.SetHostAuth(serverURL.Host, BearerAuth{Token: "host-specific"})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+	assert.Equal(t, "Bearer host-specific", resp.String())
+}
+
+func TestSetHostAuthIgnoresOtherHosts(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetAuth(BearerAuth{Token: "default"})
+	client.SetHostAuth("other.example.com", BearerAuth{Token: "elsewhere"})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+	assert.Equal(t, "Bearer default", resp.String())
+}
+
+func TestAPIKeyAuthOnClient(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(r.Header.Get("X-API-Key")))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetAuth(APIKeyAuth{Key: "X-API-Key", Value: "secret"})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+	assert.Equal(t, "secret", resp.String())
+}
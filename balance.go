@@ -0,0 +1,123 @@
+package requests
+
+import "time"
+
+// WeightedURL pairs a base URL with its share of traffic. Weights are
+// relative; an endpoint with weight 2 receives twice the requests of one
+// with weight 1. A weight of zero drains the endpoint: it is only used when
+// every weighted endpoint is down.
+type WeightedURL struct {
+	URL    string
+	Weight int
+}
+
+// BaseURLStat is a snapshot of one endpoint in the base URL pool.
+type BaseURLStat struct {
+	URL      string
+	Weight   int
+	Requests int64
+	Errors   int64
+	Down     bool
+}
+
+// SetWeightedBaseURLs configures the base URL pool as a weighted balancer
+// instead of an ordered failover list: each request picks an endpoint by
+// smooth weighted round-robin over the healthy entries, and still fails over
+// to the remaining ones on connection errors or 5xx. Intended for scatter
+// across stateless replicas that are not behind a load balancer. The first
+// URL becomes the client's BaseURL for Build and single-URL paths.
+func (c *Client) SetWeightedBaseURLs(urls ...WeightedURL) *Client {
+	entries := make([]*baseURLEntry, 0, len(urls))
+	for _, u := range urls {
+		weight := u.Weight
+		if weight < 0 {
+			weight = 0
+		}
+		entries = append(entries, &baseURLEntry{url: u.URL, weight: weight})
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(urls) > 0 {
+		c.BaseURL = urls[0].URL
+	}
+	c.baseURLs = &baseURLPool{entries: entries, balanced: true}
+	return c
+}
+
+// SetBaseURLWeight updates one endpoint's weight at runtime, e.g. to drain a
+// replica before maintenance or shift traffic toward a scaled-up region.
+// Unknown URLs are ignored.
+func (c *Client) SetBaseURLWeight(url string, weight int) *Client {
+	pool := c.getBaseURLPool()
+	if pool == nil {
+		return c
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for _, entry := range pool.entries {
+		if entry.url == url {
+			if weight < 0 {
+				weight = 0
+			}
+			entry.weight = weight
+			break
+		}
+	}
+	return c
+}
+
+// BaseURLStats returns a per-endpoint snapshot of the pool: configured
+// weight, request and error counts, and whether the endpoint is currently
+// cooling down. It returns nil when no pool is configured.
+func (c *Client) BaseURLStats() []BaseURLStat {
+	pool := c.getBaseURLPool()
+	if pool == nil {
+		return nil
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	now := time.Now()
+	stats := make([]BaseURLStat, 0, len(pool.entries))
+	for _, entry := range pool.entries {
+		stats = append(stats, BaseURLStat{
+			URL:      entry.url,
+			Weight:   entry.weight,
+			Requests: entry.requests,
+			Errors:   entry.errors,
+			Down:     entry.downUntil.After(now),
+		})
+	}
+	return stats
+}
+
+// reorderWeightedLocked moves the smooth-weighted-round-robin pick to the
+// front of the healthy slice, leaving the rest as failover candidates.
+// Called with the pool mutex held. Zero-weight entries never win the pick
+// but stay in the list as a last resort.
+func (p *baseURLPool) reorderWeightedLocked(healthy []*baseURLEntry) {
+	total := 0
+	var best *baseURLEntry
+	bestIndex := 0
+	for i, entry := range healthy {
+		if entry.weight <= 0 {
+			continue
+		}
+		entry.currentWeight += entry.weight
+		total += entry.weight
+		if best == nil || entry.currentWeight > best.currentWeight {
+			best = entry
+			bestIndex = i
+		}
+	}
+	if best == nil {
+		return
+	}
+	best.currentWeight -= total
+	healthy[0], healthy[bestIndex] = healthy[bestIndex], healthy[0]
+}
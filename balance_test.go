@@ -0,0 +1,110 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingServer(hits *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestWeightedBalancingDistribution(t *testing.T) {
+	var heavyHits, lightHits atomic.Int32
+	heavy := countingServer(&heavyHits)
+	defer heavy.Close()
+	light := countingServer(&lightHits)
+	defer light.Close()
+
+	client := Create(nil)
+	client.SetWeightedBaseURLs(
+		WeightedURL{URL: heavy.URL, Weight: 3},
+		WeightedURL{URL: light.URL, Weight: 1},
+	)
+
+	for i := 0; i < 40; i++ {
+		_, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(30), heavyHits.Load())
+	assert.Equal(t, int32(10), lightHits.Load())
+}
+
+func TestWeightedBalancingFailsOverToHealthyReplica(t *testing.T) {
+	var healthyHits atomic.Int32
+	healthy := countingServer(&healthyHits)
+	defer healthy.Close()
+
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+
+	client := Create(nil)
+	client.SetWeightedBaseURLs(
+		WeightedURL{URL: broken.URL, Weight: 1},
+		WeightedURL{URL: healthy.URL, Weight: 1},
+	)
+
+	for i := 0; i < 6; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+	}
+	assert.Equal(t, int32(6), healthyHits.Load())
+}
+
+func TestDynamicWeightUpdateDrainsEndpoint(t *testing.T) {
+	var aHits, bHits atomic.Int32
+	serverA := countingServer(&aHits)
+	defer serverA.Close()
+	serverB := countingServer(&bHits)
+	defer serverB.Close()
+
+	client := Create(nil)
+	client.SetWeightedBaseURLs(
+		WeightedURL{URL: serverA.URL, Weight: 1},
+		WeightedURL{URL: serverB.URL, Weight: 1},
+	)
+	client.SetBaseURLWeight(serverA.URL, 0)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(0), aHits.Load())
+	assert.Equal(t, int32(4), bHits.Load())
+}
+
+func TestBaseURLStatsReflectTraffic(t *testing.T) {
+	var hits atomic.Int32
+	server := countingServer(&hits)
+	defer server.Close()
+
+	client := Create(nil)
+	client.SetWeightedBaseURLs(WeightedURL{URL: server.URL, Weight: 2})
+
+	for i := 0; i < 3; i++ {
+		_, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+	}
+
+	stats := client.BaseURLStats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, server.URL, stats[0].URL)
+	assert.Equal(t, 2, stats[0].Weight)
+	assert.Equal(t, int64(3), stats[0].Requests)
+	assert.Equal(t, int64(0), stats[0].Errors)
+	assert.False(t, stats[0].Down)
+}
@@ -0,0 +1,120 @@
+package requests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+)
+
+// BatchSubRequest describes one sub-request packed into a multipart/mixed
+// $batch call.
+type BatchSubRequest struct {
+	Method string      // HTTP method; defaults to GET
+	Path   string      // Request target as it should appear inside the batch part
+	Header http.Header // Optional headers for the sub-request
+	Body   []byte      // Optional body; Content-Length is added automatically
+}
+
+// Batch packs the sub-requests into a single multipart/mixed request in the
+// OData / Microsoft Graph $batch style, posts it to the given path, and splits
+// the multipart response back into one *Response per part, in order.
+func (c *Client) Batch(ctx context.Context, path string, subRequests ...*BatchSubRequest) ([]*Response, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for _, sub := range subRequests {
+		partHeader := make(textproto.MIMEHeader)
+		partHeader.Set("Content-Type", "application/http")
+		partHeader.Set("Content-Transfer-Encoding", "binary")
+
+		part, err := writer.CreatePart(partHeader)
+		if err != nil {
+			return nil, err
+		}
+
+		method := sub.Method
+		if method == "" {
+			method = http.MethodGet
+		}
+		fmt.Fprintf(part, "%s %s HTTP/1.1\r\n", method, sub.Path)
+		for key, values := range sub.Header {
+			for _, value := range values {
+				fmt.Fprintf(part, "%s: %s\r\n", key, value)
+			}
+		}
+		if len(sub.Body) > 0 {
+			fmt.Fprintf(part, "Content-Length: %d\r\n", len(sub.Body))
+		}
+		if _, err := io.WriteString(part, "\r\n"); err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(sub.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Post(path).
+		ContentType("multipart/mixed; boundary=" + writer.Boundary()).
+		Body(buf.Bytes()).
+		Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close() //nolint:errcheck
+
+	return splitBatchResponse(ctx, resp)
+}
+
+// splitBatchResponse parses a multipart/mixed batch response into the wrapped
+// responses of the individual sub-requests.
+func splitBatchResponse(ctx context.Context, resp *Response) ([]*Response, error) {
+	mediaType, params, err := mime.ParseMediaType(resp.ContentType())
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedContentType, resp.ContentType())
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(resp.Body()), params["boundary"])
+	var responses []*Response
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		subResp, err := http.ReadResponse(bufio.NewReader(part), nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrResponseReadFailed, err) //nolint:errorlint
+		}
+
+		wrapped, err := NewResponse(ctx, subResp, resp.Client, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// NewResponse buffers the body in a pooled buffer that is reused as
+		// soon as the next part is wrapped; detach this sub-response's body
+		// onto its own backing array so later parts cannot clobber it.
+		body := append([]byte(nil), wrapped.Body()...)
+		wrapped.BodyBytes = body
+		wrapped.RawResponse.Body = io.NopCloser(bytes.NewReader(body))
+
+		responses = append(responses, wrapped)
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,90 @@
+package requests
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchEchoHandler parses a $batch request and answers each sub-request with
+// a small JSON body naming the method and path it saw.
+func batchEchoHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		writer := multipart.NewWriter(w)
+		w.Header().Set("Content-Type", "multipart/mixed; boundary="+writer.Boundary())
+
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+
+			subReq, err := http.ReadRequest(bufio.NewReader(part))
+			require.NoError(t, err)
+
+			partHeader := make(textproto.MIMEHeader)
+			partHeader.Set("Content-Type", "application/http")
+			out, err := writer.CreatePart(partHeader)
+			require.NoError(t, err)
+
+			body := fmt.Sprintf(`{"method":%q,"path":%q}`, subReq.Method, subReq.URL.Path)
+			fmt.Fprintf(out, "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\nContent-Length: %d\r\n\r\n%s", len(body), body)
+		}
+		require.NoError(t, writer.Close())
+	}
+}
+
+func TestBatchRequest(t *testing.T) {
+	server := httptest.NewServer(batchEchoHandler(t))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	responses, err := client.Batch(context.Background(), "/$batch",
+		&BatchSubRequest{Path: "/users/1"},
+		&BatchSubRequest{Method: http.MethodPost, Path: "/users", Body: []byte(`{"name":"alice"}`)},
+	)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+
+	var echoed struct {
+		Method string `json:"method"`
+		Path   string `json:"path"`
+	}
+	require.NoError(t, responses[0].Scan(&echoed))
+	assert.Equal(t, http.MethodGet, echoed.Method)
+	assert.Equal(t, "/users/1", echoed.Path)
+
+	require.NoError(t, responses[1].Scan(&echoed))
+	assert.Equal(t, http.MethodPost, echoed.Method)
+	assert.Equal(t, "/users", echoed.Path)
+	assert.True(t, responses[1].IsSuccess())
+}
+
+func TestBatchRejectsNonMultipartResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	_, err := client.Batch(context.Background(), "/$batch", &BatchSubRequest{Path: "/"})
+	assert.ErrorIs(t, err, ErrUnsupportedContentType)
+}
@@ -0,0 +1,154 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// placeholderPattern extracts `{name}` placeholders from a path template.
+var placeholderPattern = regexp.MustCompile(`\{([^}?*]+)[?*]?\}`)
+
+// contextType and errorType are cached for signature checks during binding.
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Bind populates the function-typed fields of target — a pointer to a struct
+// describing an API — with implementations backed by the client, so small
+// internal APIs can be consumed without hand-writing per-endpoint functions:
+//
+//	type UserAPI struct {
+//		Get    func(ctx context.Context, id string) (*User, error) `method:"GET" path:"/users/{id}"`
+//		Create func(ctx context.Context, u *User) (*User, error)   `method:"POST" path:"/users"`
+//		Delete func(ctx context.Context, id string) error          `method:"DELETE" path:"/users/{id}"`
+//	}
+//
+// Each bound function takes a context first, then one argument per `{name}`
+// placeholder in path order, then an optional body argument. It returns
+// either just an error, or a decoded result (pointer or value, filled via
+// Scan) and an error; a *Response result skips decoding. Fields without a
+// method tag are left untouched.
+func (c *Client) Bind(target interface{}) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: Bind target must be a pointer to a struct, got %T", ErrUnsupportedDataType, target)
+	}
+
+	structValue := value.Elem()
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		method, ok := field.Tag.Lookup("method")
+		if !ok {
+			continue
+		}
+		path := field.Tag.Get("path")
+
+		if field.Type.Kind() != reflect.Func {
+			return fmt.Errorf("%w: field %s has a method tag but is not a func", ErrUnsupportedDataType, field.Name)
+		}
+		impl, err := c.bindEndpoint(field, strings.ToUpper(method), path)
+		if err != nil {
+			return err
+		}
+		structValue.Field(i).Set(impl)
+	}
+	return nil
+}
+
+// bindEndpoint builds one endpoint implementation after validating the
+// field's signature against its tags.
+func (c *Client) bindEndpoint(field reflect.StructField, method, path string) (reflect.Value, error) {
+	fn := field.Type
+
+	placeholders := make([]string, 0)
+	for _, match := range placeholderPattern.FindAllStringSubmatch(path, -1) {
+		placeholders = append(placeholders, match[1])
+	}
+
+	if fn.NumIn() < 1 || !fn.In(0).Implements(contextType) {
+		return reflect.Value{}, fmt.Errorf("%w: field %s must take a context.Context first", ErrUnsupportedDataType, field.Name)
+	}
+	extraArgs := fn.NumIn() - 1 - len(placeholders)
+	if extraArgs < 0 || extraArgs > 1 {
+		return reflect.Value{}, fmt.Errorf("%w: field %s takes %d args for %d path params and at most one body",
+			ErrUnsupportedDataType, field.Name, fn.NumIn()-1, len(placeholders))
+	}
+	hasBody := extraArgs == 1
+
+	if fn.NumOut() < 1 || fn.NumOut() > 2 || fn.Out(fn.NumOut()-1) != errorType {
+		return reflect.Value{}, fmt.Errorf("%w: field %s must return (result, error) or error", ErrUnsupportedDataType, field.Name)
+	}
+	hasResult := fn.NumOut() == 2
+	var resultType reflect.Type
+	if hasResult {
+		resultType = fn.Out(0)
+	}
+
+	impl := reflect.MakeFunc(fn, func(args []reflect.Value) []reflect.Value {
+		ctx := args[0].Interface().(context.Context) //nolint:forcetypeassert // Checked during binding.
+
+		builder := c.NewRequestBuilder(method, path)
+		for i, name := range placeholders {
+			builder.PathParam(name, fmt.Sprint(args[1+i].Interface()))
+		}
+		if hasBody {
+			builder.Body(args[len(args)-1].Interface())
+		}
+
+		resp, err := builder.Send(ctx)
+		return bindResults(resp, err, hasResult, resultType)
+	})
+	return impl, nil
+}
+
+// bindResults converts a Send outcome into the bound function's return
+// values, decoding the body into the declared result type when there is one.
+func bindResults(resp *Response, err error, hasResult bool, resultType reflect.Type) []reflect.Value {
+	wrapErr := func(err error) reflect.Value {
+		value := reflect.New(errorType).Elem()
+		if err != nil {
+			value.Set(reflect.ValueOf(err))
+		}
+		return value
+	}
+
+	if !hasResult {
+		if err == nil && resp != nil {
+			_ = resp.Close()
+		}
+		return []reflect.Value{wrapErr(err)}
+	}
+
+	result := reflect.New(resultType).Elem()
+	if err != nil {
+		return []reflect.Value{result, wrapErr(err)}
+	}
+
+	// A *Response result hands the response to the caller, who owns closing it.
+	if resultType == reflect.TypeOf(resp) {
+		result.Set(reflect.ValueOf(resp))
+		return []reflect.Value{result, wrapErr(nil)}
+	}
+	defer func() { _ = resp.Close() }()
+
+	if resultType.Kind() == reflect.Ptr {
+		decoded := reflect.New(resultType.Elem())
+		if scanErr := resp.Scan(decoded.Interface()); scanErr != nil {
+			return []reflect.Value{result, wrapErr(scanErr)}
+		}
+		result.Set(decoded)
+		return []reflect.Value{result, wrapErr(nil)}
+	}
+
+	decoded := reflect.New(resultType)
+	if scanErr := resp.Scan(decoded.Interface()); scanErr != nil {
+		return []reflect.Value{result, wrapErr(scanErr)}
+	}
+	result.Set(decoded.Elem())
+	return []reflect.Value{result, wrapErr(nil)}
+}
@@ -0,0 +1,89 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bindUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestBind(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/42", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"42","name":"alice"}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var u bindUser
+		_ = json.Unmarshal(body, &u)
+		u.ID = "43"
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(u)
+	})
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	type userAPI struct {
+		Get    func(ctx context.Context, id string) (*bindUser, error)  `method:"GET" path:"/users/{id}"`
+		Create func(ctx context.Context, u *bindUser) (bindUser, error) `method:"POST" path:"/users"`
+		Delete func(ctx context.Context, id string) error               `method:"DELETE" path:"/users/{id}"`
+		Helper func()                                                   // untagged fields are left alone
+	}
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	var api userAPI
+	require.NoError(t, client.Bind(&api))
+	require.NotNil(t, api.Get)
+	assert.Nil(t, api.Helper)
+
+	got, err := api.Get(context.Background(), "42")
+	require.NoError(t, err)
+	assert.Equal(t, &bindUser{ID: "42", Name: "alice"}, got)
+
+	created, err := api.Create(context.Background(), &bindUser{Name: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, bindUser{ID: "43", Name: "bob"}, created)
+
+	require.NoError(t, api.Delete(context.Background(), "42"))
+}
+
+func TestBindSignatureValidation(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.test"})
+
+	// Target must be a struct pointer.
+	assert.ErrorIs(t, client.Bind(struct{}{}), ErrUnsupportedDataType)
+
+	// The first parameter must be a context.
+	type missingContext struct {
+		Get func(id string) error `method:"GET" path:"/items/{id}"`
+	}
+	assert.ErrorIs(t, client.Bind(&missingContext{}), ErrUnsupportedDataType)
+
+	// Argument count must match the placeholders plus at most one body.
+	type tooManyArgs struct {
+		Get func(ctx context.Context, a, b, c string) error `method:"GET" path:"/items/{id}"`
+	}
+	assert.ErrorIs(t, client.Bind(&tooManyArgs{}), ErrUnsupportedDataType)
+
+	// The last return value must be an error.
+	type noError struct {
+		Get func(ctx context.Context) *bindUser `method:"GET" path:"/items"`
+	}
+	assert.ErrorIs(t, client.Bind(&noError{}), ErrUnsupportedDataType)
+}
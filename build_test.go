@@ -0,0 +1,57 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPreparesRequestWithoutSending(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	client.SetAuth(BearerAuth{Token: "token"})
+
+	req, err := client.Post("/users/{id}/notes").
+		PathParam("id", "42").
+		Query("draft", "true").
+		Header("X-Request-ID", "abc").
+		JSONBody(map[string]string{"text": "hello"}).
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "http://example.com/users/42/notes?draft=true", req.URL.String())
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+	assert.Equal(t, "abc", req.Header.Get("X-Request-ID"))
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text":"hello"}`, string(body))
+
+	// The body is rewindable, as Send would need for retries.
+	require.NotNil(t, req.GetBody)
+	rewound, err := req.GetBody()
+	require.NoError(t, err)
+	body, err = io.ReadAll(rewound)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text":"hello"}`, string(body))
+}
+
+func TestBuildReturnsValidationErrors(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	_, err := client.Get("/users/{id}").PathParam("other", "1").Build(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrMissingPathParam)
+}
+
+func TestBuildOnClosedClient(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	require.NoError(t, client.Close(context.Background()))
+
+	_, err := client.Get("/").Build(context.Background())
+	assert.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,321 @@
+package requests
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"container/list"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+	"time"
+)
+
+// Cache stores serialized responses for the cache middleware. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the stored value for key, or false when absent or expired.
+	Get(key string) ([]byte, bool)
+	// Set stores the value for key, evicting older entries as needed.
+	Set(key string, value []byte)
+	// Stats returns the cache's hit/miss/eviction counters.
+	Stats() CacheStats
+}
+
+// CacheStats holds the counters a Cache exposes for production monitoring.
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Entries   int
+	Bytes     int64
+}
+
+// memoryCacheEntry is one LRU-tracked cache value.
+type memoryCacheEntry struct {
+	key   string
+	value []byte
+}
+
+// MemoryCache is an in-memory Cache with max-entries and max-bytes limits
+// enforced by LRU eviction, and optional gzip compression of stored values,
+// so production use of the cache middleware cannot grow without bound.
+type MemoryCache struct {
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List // Front is most recently used
+	bytes    int64
+	compress bool
+
+	maxEntries int
+	maxBytes   int64
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// NewMemoryCache returns a MemoryCache bounded to maxEntries entries and
+// maxBytes of stored value bytes; zero disables the respective limit. With
+// compress true, values are gzip'd in the store and inflated on Get.
+func NewMemoryCache(maxEntries int, maxBytes int64, compress bool) *MemoryCache {
+	return &MemoryCache{
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		compress:   compress,
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+	}
+}
+
+// Get returns the stored value for key, inflating compressed values.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		c.mu.Unlock()
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	stored := element.Value.(*memoryCacheEntry).value //nolint:forcetypeassert // Only entries are stored.
+	c.hits++
+	c.mu.Unlock()
+
+	if !c.compress {
+		return stored, true
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(stored))
+	if err != nil {
+		return nil, false
+	}
+	value, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set stores the value for key, evicting least recently used entries until
+// the configured limits hold.
+func (c *MemoryCache) Set(key string, value []byte) {
+	if c.compress {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		_, _ = writer.Write(value)
+		_ = writer.Close()
+		value = buf.Bytes()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*memoryCacheEntry) //nolint:forcetypeassert // Only entries are stored.
+		c.bytes += int64(len(value)) - int64(len(entry.value))
+		entry.value = value
+		c.lru.MoveToFront(element)
+	} else {
+		c.entries[key] = c.lru.PushFront(&memoryCacheEntry{key: key, value: value})
+		c.bytes += int64(len(value))
+	}
+
+	for (c.maxEntries > 0 && c.lru.Len() > c.maxEntries) || (c.maxBytes > 0 && c.bytes > c.maxBytes && c.lru.Len() > 1) {
+		c.evictOldestLocked()
+	}
+}
+
+// evictOldestLocked removes the least recently used entry. The caller holds
+// the lock.
+func (c *MemoryCache) evictOldestLocked() {
+	element := c.lru.Back()
+	if element == nil {
+		return
+	}
+	entry := element.Value.(*memoryCacheEntry) //nolint:forcetypeassert // Only entries are stored.
+	c.lru.Remove(element)
+	delete(c.entries, entry.key)
+	c.bytes -= int64(len(entry.value))
+	c.evictions++
+}
+
+// Stats returns the cache's counters.
+func (c *MemoryCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Entries:   c.lru.Len(),
+		Bytes:     c.bytes,
+	}
+}
+
+// CacheOptions configures the cache middleware's freshness windows.
+type CacheOptions struct {
+	// TTL is the fresh window: entries younger than this are served without
+	// contacting the origin.
+	TTL time.Duration
+	// StaleWhileRevalidate extends the window past TTL: stale entries are
+	// served immediately while a single-flight background request refreshes
+	// them.
+	StaleWhileRevalidate time.Duration
+	// StaleIfError extends the window past TTL for origin failures: the
+	// origin is tried first, and the stale entry is served when it errors or
+	// returns a 5xx.
+	StaleIfError time.Duration
+}
+
+// UseCache installs a middleware serving repeated GET requests from the
+// cache for ttl. Only 2xx responses are stored; entries carry their storage
+// time, so a bounded cache (see NewMemoryCache) needs no background sweeper.
+func (c *Client) UseCache(cache Cache, ttl time.Duration) *Client {
+	return c.UseCacheWithOptions(cache, CacheOptions{TTL: ttl})
+}
+
+// UseCacheWithOptions installs the cache middleware with stampede
+// protection: see CacheOptions for the stale-while-revalidate and
+// stale-if-error windows.
+func (c *Client) UseCacheWithOptions(cache Cache, options CacheOptions) *Client {
+	guard := &cacheGuard{client: c, cache: cache, options: options, inflight: make(map[string]struct{})}
+	return c.UseNamed("cache", guard.middleware)
+}
+
+// cacheGuard wires a Cache into the middleware chain and single-flights
+// background revalidations.
+type cacheGuard struct {
+	client  *Client
+	cache   Cache
+	options CacheOptions
+
+	mu       sync.Mutex
+	inflight map[string]struct{}
+}
+
+func (g *cacheGuard) middleware(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			return next(req)
+		}
+
+		key := req.URL.String()
+		var stale *http.Response
+		if stored, ok := g.cache.Get(key); ok {
+			if resp, age := decodeCachedResponse(stored, req); resp != nil {
+				switch {
+				case age <= g.options.TTL:
+					return resp, nil
+				case age <= g.options.TTL+g.options.StaleWhileRevalidate:
+					g.revalidate(key, req)
+					return resp, nil
+				case age <= g.options.TTL+g.options.StaleIfError:
+					stale = resp
+				}
+			}
+		}
+
+		resp, err := g.fetch(key, req, next)
+		if stale != nil && (err != nil || resp == nil || resp.StatusCode >= 500) {
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return stale, nil
+		}
+		return resp, err
+	}
+}
+
+// fetch performs the origin request and stores cacheable responses.
+func (g *cacheGuard) fetch(key string, req *http.Request, next MiddlewareHandlerFunc) (*http.Response, error) {
+	resp, err := next(req)
+	if err != nil || resp == nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, err
+	}
+
+	encoded, replacement, encodeErr := encodeCachedResponse(resp)
+	if encodeErr != nil {
+		return resp, nil
+	}
+	g.cache.Set(key, encoded)
+	return replacement, nil
+}
+
+// revalidate refreshes a stale entry on a background goroutine, ensuring at
+// most one refresh per key is in flight. The refresh goes through the HTTP
+// client directly rather than replaying the captured middleware chain: that
+// chain closes over the foreground request's builder and retry state, which
+// the caller is still using.
+func (g *cacheGuard) revalidate(key string, req *http.Request) {
+	g.mu.Lock()
+	if _, busy := g.inflight[key]; busy {
+		g.mu.Unlock()
+		return
+	}
+	g.inflight[key] = struct{}{}
+	g.mu.Unlock()
+
+	// Detach from the caller's context so returning the stale response does
+	// not cancel the refresh.
+	clone := req.Clone(context.WithoutCancel(req.Context()))
+	go func() {
+		defer func() {
+			g.mu.Lock()
+			delete(g.inflight, key)
+			g.mu.Unlock()
+		}()
+
+		resp, err := g.client.getHTTPClient().Do(clone)
+		if err != nil || resp == nil {
+			return
+		}
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			// encodeCachedResponse closes the wire body either way.
+			if encoded, replacement, encodeErr := encodeCachedResponse(resp); encodeErr == nil {
+				g.cache.Set(key, encoded)
+				_ = replacement.Body.Close()
+			}
+			return
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+}
+
+// encodeCachedResponse serializes the response with its storage timestamp
+// and returns a replacement response whose body is still readable.
+func encodeCachedResponse(resp *http.Response) ([]byte, *http.Response, error) {
+	dump, err := httputil.DumpResponse(resp, true)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value := make([]byte, 8+len(dump))
+	binary.BigEndian.PutUint64(value, uint64(time.Now().UnixNano()))
+	copy(value[8:], dump)
+
+	replacement, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(dump)), resp.Request)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, replacement, nil
+}
+
+// decodeCachedResponse restores a stored response and its age, or returns
+// nil when the entry is malformed.
+func decodeCachedResponse(value []byte, req *http.Request) (*http.Response, time.Duration) {
+	if len(value) < 8 {
+		return nil, 0
+	}
+	storedAt := int64(binary.BigEndian.Uint64(value)) //nolint:gosec // Round-trip of our own encoding.
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(value[8:])), req)
+	if err != nil {
+		return nil, 0
+	}
+	return resp, time.Duration(time.Now().UnixNano() - storedAt)
+}
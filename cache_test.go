@@ -0,0 +1,188 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCacheLRUEviction(t *testing.T) {
+	cache := NewMemoryCache(2, 0, false)
+
+	cache.Set("a", []byte("1"))
+	cache.Set("b", []byte("2"))
+
+	// Touch "a" so "b" becomes the eviction candidate.
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Set("c", []byte("3"))
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+
+	stats := cache.Stats()
+	assert.Equal(t, int64(1), stats.Evictions)
+	assert.Equal(t, 2, stats.Entries)
+}
+
+func TestMemoryCacheByteLimit(t *testing.T) {
+	cache := NewMemoryCache(0, 10, false)
+
+	cache.Set("a", []byte("12345"))
+	cache.Set("b", []byte("67890"))
+	cache.Set("c", []byte("abcde"))
+
+	stats := cache.Stats()
+	assert.LessOrEqual(t, stats.Bytes, int64(10))
+	assert.GreaterOrEqual(t, stats.Evictions, int64(1))
+}
+
+func TestMemoryCacheCompression(t *testing.T) {
+	cache := NewMemoryCache(0, 0, true)
+
+	payload := []byte("compressible compressible compressible compressible")
+	cache.Set("key", payload)
+
+	got, ok := cache.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, payload, got)
+	// The stored representation is smaller than the original.
+	assert.Less(t, cache.Stats().Bytes, int64(len(payload)))
+}
+
+func TestUseCacheServesRepeatedGets(t *testing.T) {
+	var hits atomic.Int64
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"cached":true}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	cache := NewMemoryCache(16, 0, false)
+	client.UseCache(cache, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get("/data").Send(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, `{"cached":true}`, resp.String())
+		require.NoError(t, resp.Close())
+	}
+
+	assert.Equal(t, int64(1), hits.Load())
+	stats := cache.Stats()
+	assert.Equal(t, int64(2), stats.Hits)
+	assert.Equal(t, int64(1), stats.Misses)
+
+	// POST requests bypass the cache.
+	resp, err := client.Post("/data").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+	assert.Equal(t, int64(2), hits.Load())
+}
+
+func TestUseCacheRespectsTTL(t *testing.T) {
+	var hits atomic.Int64
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.UseCache(NewMemoryCache(16, 0, false), 10*time.Millisecond)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	time.Sleep(20 * time.Millisecond)
+
+	resp, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	assert.Equal(t, int64(2), hits.Load())
+}
+
+func TestCacheStaleWhileRevalidate(t *testing.T) {
+	var hits atomic.Int64
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := hits.Add(1)
+		fmt.Fprintf(w, "version-%d", n)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.UseCacheWithOptions(NewMemoryCache(16, 0, false), CacheOptions{
+		TTL:                  10 * time.Millisecond,
+		StaleWhileRevalidate: time.Minute,
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "version-1", resp.String())
+	require.NoError(t, resp.Close())
+
+	time.Sleep(20 * time.Millisecond)
+
+	// The stale entry is served immediately while a background refresh runs.
+	resp, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "version-1", resp.String())
+	require.NoError(t, resp.Close())
+
+	require.Eventually(t, func() bool { return hits.Load() == 2 }, time.Second, 5*time.Millisecond)
+
+	// The refreshed entry is now fresh.
+	resp, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "version-2", resp.String())
+	require.NoError(t, resp.Close())
+	assert.Equal(t, int64(2), hits.Load())
+}
+
+func TestCacheStaleIfError(t *testing.T) {
+	var failing atomic.Bool
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte("good"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.UseCacheWithOptions(NewMemoryCache(16, 0, false), CacheOptions{
+		TTL:          10 * time.Millisecond,
+		StaleIfError: time.Minute,
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "good", resp.String())
+	require.NoError(t, resp.Close())
+
+	time.Sleep(20 * time.Millisecond)
+	failing.Store(true)
+
+	// The origin 5xx falls back to the stale entry.
+	resp, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "good", resp.String())
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NoError(t, resp.Close())
+}
@@ -0,0 +1,113 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// SetCanonicalJSON makes JSON request bodies canonical: object keys sorted,
+// no insignificant whitespace, and number literals preserved exactly as the
+// encoder produced them. Canonical bodies keep HMAC/SigV4 signatures and test
+// golden files stable across Go versions and encoder choices.
+func (c *Client) SetCanonicalJSON(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.canonicalJSON = enabled
+	return c
+}
+
+// useCanonicalJSON returns the canonical JSON setting under the read lock.
+func (c *Client) useCanonicalJSON() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.canonicalJSON
+}
+
+// canonicalizeJSONReader re-encodes an encoded JSON body canonically.
+func canonicalizeJSONReader(r io.Reader) (io.Reader, error) {
+	data, err := io.ReadAll(r)
+	if closer, ok := r.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := canonicalizeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(canonical), nil
+}
+
+// canonicalizeJSON rewrites a JSON document with sorted object keys and no
+// insignificant whitespace. Numbers pass through as their original literals
+// via json.Number, so float formatting cannot drift between encoders.
+func canonicalizeJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEncodingFailed, err) //nolint:errorlint
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalValue(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeCanonicalValue writes one decoded JSON value in canonical form.
+func writeCanonicalValue(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			encodedKey, err := json.Marshal(key)
+			if err != nil {
+				return err
+			}
+			buf.Write(encodedKey)
+			buf.WriteByte(':')
+			if err := writeCanonicalValue(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalValue(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case json.Number:
+		buf.WriteString(v.String())
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(encoded)
+	}
+	return nil
+}
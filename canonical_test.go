@@ -0,0 +1,70 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "sorted keys and stripped whitespace",
+			input:    `{"b": 2, "a": 1}`,
+			expected: `{"a":1,"b":2}`,
+		},
+		{
+			name:     "nested objects and arrays",
+			input:    `{"z": {"y": [3, {"x": true}]}, "a": null}`,
+			expected: `{"a":null,"z":{"y":[3,{"x":true}]}}`,
+		},
+		{
+			name:     "number literals preserved",
+			input:    `{"price": 1.10, "big": 9007199254740993}`,
+			expected: `{"big":9007199254740993,"price":1.10}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			canonical, err := canonicalizeJSON([]byte(tc.input))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(canonical))
+		})
+	}
+}
+
+func TestCanonicalizeJSONRejectsInvalidInput(t *testing.T) {
+	_, err := canonicalizeJSON([]byte(`{"a":`))
+	assert.ErrorIs(t, err, ErrEncodingFailed)
+}
+
+func TestSetCanonicalJSONOnRequests(t *testing.T) {
+	var got string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		got = string(data)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetCanonicalJSON(true)
+
+	resp, err := client.Post("/").
+		JSONBody(map[string]interface{}{"beta": 2, "alpha": 1}).
+		Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, `{"alpha":1,"beta":2}`, got)
+}
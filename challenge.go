@@ -0,0 +1,101 @@
+package requests
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxChallengeReplays bounds how often a single call may be replayed after a
+// solved challenge, independent of the retry budget.
+const maxChallengeReplays = 3
+
+// ChallengeDetector reports whether a response is a cookie consent or
+// anti-bot challenge that should be handed to the challenge handler. The
+// buffered body is passed alongside the response so markers can be inspected
+// without consuming it.
+type ChallengeDetector func(resp *http.Response, body []byte) bool
+
+// ChallengeHandler solves a detected challenge, e.g. via an external service.
+// It may mutate the request (cookies, headers) before the replay and returns
+// whether the request should be replayed.
+type ChallengeHandler func(req *http.Request, resp *http.Response, body []byte) (replay bool, err error)
+
+// challengeBodyMarkers are the body substrings matched by the default
+// challenge detector.
+var challengeBodyMarkers = []string{"captcha", "cf-chl", "challenge-platform", "consent"}
+
+// DefaultChallengeDetector matches 403 and 503 responses whose body carries
+// common anti-bot or consent markers.
+func DefaultChallengeDetector(resp *http.Response, body []byte) bool {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusServiceUnavailable {
+		return false
+	}
+
+	lower := strings.ToLower(string(body))
+	for _, marker := range challengeBodyMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// challengeConfig pairs a handler with the detectors that trigger it.
+type challengeConfig struct {
+	handler   ChallengeHandler
+	detectors []ChallengeDetector
+}
+
+// SetChallengeHandler installs a handler invoked inside the retry loop when a
+// response matches one of the detectors; DefaultChallengeDetector is used
+// when none are given. When the handler returns true the request body is
+// rewound and the request replayed, up to a bounded number of times. A nil
+// handler removes challenge handling.
+func (c *Client) SetChallengeHandler(handler ChallengeHandler, detectors ...ChallengeDetector) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if handler == nil {
+		c.challenge = nil
+		return c
+	}
+	if len(detectors) == 0 {
+		detectors = []ChallengeDetector{DefaultChallengeDetector}
+	}
+	c.challenge = &challengeConfig{handler: handler, detectors: detectors}
+	return c
+}
+
+// getChallengeConfig returns the challenge configuration under the read lock.
+func (c *Client) getChallengeConfig() *challengeConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.challenge
+}
+
+// handleChallenge checks a response against the configured detectors and, on
+// a match, lets the handler solve the challenge. The response body is
+// buffered and restored so callers can still read it. It reports whether the
+// request should be replayed.
+func (cfg *challengeConfig) handleChallenge(req *http.Request, resp *http.Response) (bool, error) {
+	if resp == nil {
+		return false, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, nil //nolint:nilerr // An unreadable body is not a challenge.
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	for _, detector := range cfg.detectors {
+		if detector(resp, body) {
+			return cfg.handler(req, resp, body)
+		}
+	}
+	return false, nil
+}
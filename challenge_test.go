@@ -0,0 +1,101 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeHandlerReplaysRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("clearance"); err == nil && cookie.Value == "granted" {
+			fmt.Fprint(w, "content")
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, "please solve this captcha")
+	}))
+	defer server.Close()
+
+	var handled int32
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetChallengeHandler(func(req *http.Request, resp *http.Response, body []byte) (bool, error) {
+		atomic.AddInt32(&handled, 1)
+		// "Solve" the challenge by attaching the clearance cookie.
+		req.AddCookie(&http.Cookie{Name: "clearance", Value: "granted"})
+		return true, nil
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, "content", resp.String())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&handled))
+}
+
+func TestChallengeHandlerInsideRetryLoop(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "challenge-platform interstitial")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{
+		BaseURL:       server.URL,
+		MaxRetries:    2,
+		RetryStrategy: DefaultBackoffStrategy(time.Millisecond),
+	})
+	client.SetChallengeHandler(func(req *http.Request, resp *http.Response, body []byte) (bool, error) {
+		return true, nil
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.True(t, resp.IsSuccess())
+	assert.Equal(t, 2, resp.Attempts())
+}
+
+func TestChallengeHandlerDeclines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, "captcha required")
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetChallengeHandler(func(req *http.Request, resp *http.Response, body []byte) (bool, error) {
+		return false, nil
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	// The original challenge response is returned, body intact.
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+	assert.Equal(t, "captcha required", resp.String())
+}
+
+func TestDefaultChallengeDetector(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusForbidden}
+	assert.True(t, DefaultChallengeDetector(resp, []byte("<html>Captcha check</html>")))
+	assert.False(t, DefaultChallengeDetector(resp, []byte("plain forbidden")))
+
+	resp.StatusCode = http.StatusOK
+	assert.False(t, DefaultChallengeDetector(resp, []byte("captcha")))
+}
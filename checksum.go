@@ -0,0 +1,132 @@
+package requests
+
+import (
+	"bytes"
+	"crypto/md5"  //nolint:gosec // Supported for Content-MD5 integrity headers.
+	"crypto/sha1" //nolint:gosec // Supported for Digest/x-amz-checksum headers.
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// amzChecksumAlgos are the algorithms probed in x-amz-checksum-* headers.
+var amzChecksumAlgos = []string{"sha256", "sha1", "crc32"}
+
+// newChecksumHash returns the hash implementation for a checksum algorithm.
+func newChecksumHash(algo string) (hash.Hash, error) {
+	switch strings.ToLower(algo) {
+	case "md5":
+		return md5.New(), nil //nolint:gosec // Integrity check, not cryptography.
+	case "sha1":
+		return sha1.New(), nil //nolint:gosec // Integrity check, not cryptography.
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	case "crc32":
+		return crc32.NewIEEE(), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported checksum algorithm %q", ErrChecksumMismatch, algo)
+	}
+}
+
+// checksumFromHeader extracts an expected checksum from Content-MD5,
+// x-amz-checksum-*, or Digest response headers.
+func checksumFromHeader(header http.Header) (string, []byte) {
+	if value := header.Get("Content-MD5"); value != "" {
+		if sum, err := base64.StdEncoding.DecodeString(value); err == nil {
+			return "md5", sum
+		}
+	}
+
+	for _, algo := range amzChecksumAlgos {
+		if value := header.Get("x-amz-checksum-" + algo); value != "" {
+			if sum, err := base64.StdEncoding.DecodeString(value); err == nil {
+				return algo, sum
+			}
+		}
+	}
+
+	// RFC 3230 Digest: comma-separated algo=base64 pairs, e.g.
+	// "sha-256=X48E9qOokqqrvdts8nOJRJN3OWDUoyWxBf7kbu9DBPE=".
+	if value := header.Get("Digest"); value != "" {
+		for _, entry := range strings.Split(value, ",") {
+			algo, encoded, found := strings.Cut(strings.TrimSpace(entry), "=")
+			if !found {
+				continue
+			}
+			algo = strings.ReplaceAll(strings.ToLower(algo), "-", "")
+			if sum, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+				return algo, sum
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// checksumReader hashes the body as it is read and replaces the final EOF
+// with ErrChecksumMismatch when the digest does not match.
+type checksumReader struct {
+	rc       io.ReadCloser
+	hash     hash.Hash
+	algo     string
+	expected []byte
+}
+
+func (cr *checksumReader) Read(p []byte) (int, error) {
+	n, err := cr.rc.Read(p)
+	if n > 0 {
+		cr.hash.Write(p[:n])
+	}
+	if errors.Is(err, io.EOF) && !bytes.Equal(cr.hash.Sum(nil), cr.expected) {
+		return n, fmt.Errorf("%w: %s expected %s, got %s", ErrChecksumMismatch,
+			cr.algo, hex.EncodeToString(cr.expected), hex.EncodeToString(cr.hash.Sum(nil)))
+	}
+	return n, err
+}
+
+func (cr *checksumReader) Close() error {
+	return cr.rc.Close()
+}
+
+// attachChecksum wraps the response body with checksum verification when the
+// builder carries an explicit expectation or the response headers advertise
+// one. Unknown algorithms in headers are ignored; an explicit expectation
+// with an unknown algorithm or invalid hex fails.
+func (b *RequestBuilder) attachChecksum(resp *http.Response) error {
+	algo := b.checksumAlgo
+	var expected []byte
+
+	if algo != "" {
+		var err error
+		expected, err = hex.DecodeString(b.checksumHex)
+		if err != nil {
+			return fmt.Errorf("%w: invalid expected checksum hex: %v", ErrChecksumMismatch, err) //nolint:errorlint
+		}
+	} else {
+		algo, expected = checksumFromHeader(resp.Header)
+		if algo == "" || len(expected) == 0 {
+			return nil
+		}
+	}
+
+	h, err := newChecksumHash(algo)
+	if err != nil {
+		if b.checksumAlgo != "" {
+			return err
+		}
+		return nil
+	}
+
+	resp.Body = &checksumReader{rc: resp.Body, hash: h, algo: algo, expected: expected}
+	return nil
+}
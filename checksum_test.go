@@ -0,0 +1,106 @@
+package requests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectChecksum(t *testing.T) {
+	body := []byte("release artifact")
+	sum := sha256.Sum256(body)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").
+		ExpectChecksum("sha256", hex.EncodeToString(sum[:])).
+		Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, body, resp.Body())
+
+	// A wrong expectation fails the read with ErrChecksumMismatch.
+	_, err = client.Get("/").
+		ExpectChecksum("sha256", hex.EncodeToString(make([]byte, sha256.Size))).
+		Send(context.Background())
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+	// Unknown algorithms are rejected when set explicitly.
+	_, err = client.Get("/").
+		ExpectChecksum("blake3", "00").
+		Send(context.Background())
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestChecksumFromResponseHeaders(t *testing.T) {
+	body := []byte("object data")
+	sum := sha256.Sum256(body)
+
+	corrupt := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-amz-checksum-sha256", base64.StdEncoding.EncodeToString(sum[:]))
+		if corrupt {
+			_, _ = w.Write([]byte("tampered"))
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, body, resp.Body())
+
+	corrupt = true
+	_, err = client.Get("/").Send(context.Background())
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestChecksumDigestHeader(t *testing.T) {
+	body := []byte("digest body")
+	sum := sha256.Sum256(body)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Digest", fmt.Sprintf("sha-256=%s", base64.StdEncoding.EncodeToString(sum[:])))
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, body, resp.Body())
+}
+
+func TestChecksumUnknownHeaderAlgoIgnored(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Digest", "blake3=AAAA")
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "ok", resp.String())
+}
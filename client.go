@@ -1,9 +1,14 @@
 package requests
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
+	"encoding/json"
 	"net/http"
 	"net/http/cookiejar"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 )
@@ -14,6 +19,7 @@ type Client struct {
 	BaseURL       string
 	Headers       *http.Header
 	Cookies       []*http.Cookie
+	Queries       url.Values
 	Middlewares   []Middleware
 	TLSConfig     *tls.Config
 	MaxRetries    int             // Maximum number of retry attempts
@@ -28,6 +34,58 @@ type Client struct {
 	YAMLDecoder   Decoder
 	Logger        Logger
 	auth          AuthMethod
+	onRetry       RetryHook
+	scanFallback  ContentFormat
+	metrics       *poolMetrics
+	closed        bool
+	closeHooks    []func()
+
+	// middlewareNames holds the name of each entry in Middlewares; anonymous
+	// middlewares added via AddMiddleware have an empty name.
+	middlewareNames []string
+
+	responseInterceptors []ResponseInterceptor
+	failOnProblem        bool
+	envelope             *responseEnvelope
+	ipFamily             IPFamily
+	fallbackDelay        time.Duration
+	scheduler            *scheduler
+	challenge            *challengeConfig
+	strictDecode         bool
+	contentTypeInferrer  ContentTypeInferrer
+	userAgents           []string
+	uaRotatePerRequest   bool
+	uaIndex              int
+	headerProfile        http.Header
+	reauth               *reauthManager
+	slog                 *slogConfig
+	redactor             Redactor
+	bodyTransformer      BodyTransformer
+	hostAuth             map[string]AuthMethod
+	backoffFromResponse  BackoffFromResponse
+	errorArtifactDir     string
+	noDefaultUserAgent   bool
+	canonicalJSON        bool
+	staleConnPolicy      StaleConnectionPolicy
+	http2Settings        *HTTP2Settings
+	http1Fallback        bool
+	http1Transport       http.RoundTripper
+	rejectGetBody        bool
+	autoIdempotencyKey   bool
+	limits               Limits
+	altSvc               *altSvcCache
+	queryEncoding        QueryEncoding
+	queriesStructTag     string
+	configErrs           []error
+	audit                *auditLog
+	headerProvider       HeaderProvider
+	spillThreshold       int64
+	spillDir             string
+	baseURLs             *baseURLPool
+
+	// perAttemptMiddlewares wrap each network attempt rather than the whole
+	// retry loop, so e.g. signing middleware re-signs on every retry.
+	perAttemptMiddlewares []Middleware
 }
 
 // Config sets up the initial configuration for the HTTP client.
@@ -83,6 +141,7 @@ func Create(config *Config) *Client {
 		YAMLEncoder: DefaultYAMLEncoder,
 		YAMLDecoder: DefaultYAMLDecoder,
 		TLSConfig:   config.TLSConfig,
+		metrics:     &poolMetrics{},
 	}
 
 	// If a TLS configuration is provided, apply it to the Transport.
@@ -128,6 +187,147 @@ func Create(config *Config) *Client {
 	return client
 }
 
+// Read-locked accessors used by the send path. They copy mutable state so
+// in-flight requests do not race with concurrent client mutation.
+
+// getBaseURL returns the base URL under the read lock.
+func (c *Client) getBaseURL() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.BaseURL
+}
+
+// getHeaders returns a clone of the default headers under the read lock.
+func (c *Client) getHeaders() http.Header {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.Headers == nil {
+		return nil
+	}
+	return c.Headers.Clone()
+}
+
+// getCookies returns a copy of the default cookies under the read lock.
+func (c *Client) getCookies() []*http.Cookie {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.Cookies == nil {
+		return nil
+	}
+	cookies := make([]*http.Cookie, len(c.Cookies))
+	copy(cookies, c.Cookies)
+	return cookies
+}
+
+// getQueries returns a copy of the default query parameters under the read lock.
+func (c *Client) getQueries() url.Values {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.Queries == nil {
+		return nil
+	}
+	queries := make(url.Values, len(c.Queries))
+	for key, values := range c.Queries {
+		queries[key] = append([]string(nil), values...)
+	}
+	return queries
+}
+
+// getMiddlewares returns a copy of the middleware stack under the read lock.
+func (c *Client) getMiddlewares() []Middleware {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.Middlewares == nil {
+		return nil
+	}
+	middlewares := make([]Middleware, len(c.Middlewares))
+	copy(middlewares, c.Middlewares)
+	return middlewares
+}
+
+// getMiddlewareEntries returns copies of the middleware stack and its names
+// under the read lock; names are padded for middlewares added directly.
+func (c *Client) getMiddlewareEntries() ([]Middleware, []string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	middlewares := make([]Middleware, len(c.Middlewares))
+	copy(middlewares, c.Middlewares)
+
+	names := make([]string, len(middlewares))
+	copy(names, c.middlewareNames)
+	return middlewares, names
+}
+
+// getRetryConfig returns the retry settings under the read lock.
+func (c *Client) getRetryConfig() (int, BackoffStrategy, RetryIfFunc) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.MaxRetries, c.RetryStrategy, c.RetryIf
+}
+
+// getOnRetry returns the retry hook under the read lock.
+func (c *Client) getOnRetry() RetryHook {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.onRetry
+}
+
+// getHTTPClient returns the underlying HTTP client under the read lock.
+func (c *Client) getHTTPClient() *http.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.HTTPClient
+}
+
+// getLogger returns the logger under the read lock.
+func (c *Client) getLogger() Logger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.Logger
+}
+
+// getAuth returns the authentication method under the read lock.
+func (c *Client) getAuth() AuthMethod {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.auth
+}
+
+// getJSONEncoder returns the JSON encoder under the read lock.
+func (c *Client) getJSONEncoder() Encoder {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.JSONEncoder
+}
+
+// getXMLEncoder returns the XML encoder under the read lock.
+func (c *Client) getXMLEncoder() Encoder {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.XMLEncoder
+}
+
+// getYAMLEncoder returns the YAML encoder under the read lock.
+func (c *Client) getYAMLEncoder() Encoder {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.YAMLEncoder
+}
+
 // SetBaseURL sets the base URL for the client
 func (c *Client) SetBaseURL(baseURL string) {
 	c.mu.Lock()
@@ -141,7 +341,220 @@ func (c *Client) AddMiddleware(middlewares ...Middleware) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	c.syncMiddlewareNames()
 	c.Middlewares = append(c.Middlewares, middlewares...)
+	for range middlewares {
+		c.middlewareNames = append(c.middlewareNames, "")
+	}
+}
+
+// AddPerAttemptMiddleware adds middleware that runs once per network attempt
+// instead of wrapping the whole retry loop, so request signing (SigV4, HMAC)
+// produces fresh signatures after long backoffs. The attempt number is
+// available via AttemptFromContext.
+func (c *Client) AddPerAttemptMiddleware(middlewares ...Middleware) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.perAttemptMiddlewares = append(c.perAttemptMiddlewares, middlewares...)
+	return c
+}
+
+// getPerAttemptMiddlewares returns a copy of the per-attempt middleware
+// stack under the read lock.
+func (c *Client) getPerAttemptMiddlewares() []Middleware {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	middlewares := make([]Middleware, len(c.perAttemptMiddlewares))
+	copy(middlewares, c.perAttemptMiddlewares)
+	return middlewares
+}
+
+// syncMiddlewareNames pads the name list to match Middlewares, so direct
+// mutation of the exported slice cannot desync the bookkeeping. Callers must
+// hold the write lock.
+func (c *Client) syncMiddlewareNames() {
+	for len(c.middlewareNames) < len(c.Middlewares) {
+		c.middlewareNames = append(c.middlewareNames, "")
+	}
+	if len(c.middlewareNames) > len(c.Middlewares) {
+		c.middlewareNames = c.middlewareNames[:len(c.Middlewares)]
+	}
+}
+
+// UseNamed adds a middleware under a name, replacing any existing middleware
+// with the same name in place so its position in the chain is preserved.
+func (c *Client) UseNamed(name string, middleware Middleware) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.syncMiddlewareNames()
+	for i, existing := range c.middlewareNames {
+		if existing == name {
+			c.Middlewares[i] = middleware
+			return c
+		}
+	}
+
+	c.Middlewares = append(c.Middlewares, middleware)
+	c.middlewareNames = append(c.middlewareNames, name)
+	return c
+}
+
+// RemoveMiddleware removes the named middleware from the chain, reporting
+// whether it was present.
+func (c *Client) RemoveMiddleware(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.syncMiddlewareNames()
+	for i, existing := range c.middlewareNames {
+		if existing == name {
+			c.Middlewares = append(c.Middlewares[:i], c.Middlewares[i+1:]...)
+			c.middlewareNames = append(c.middlewareNames[:i], c.middlewareNames[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// InsertBefore inserts a middleware immediately before the named one,
+// reporting whether the named middleware was found.
+func (c *Client) InsertBefore(name string, middleware Middleware) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.syncMiddlewareNames()
+	for i, existing := range c.middlewareNames {
+		if existing == name {
+			c.Middlewares = append(c.Middlewares[:i], append([]Middleware{middleware}, c.Middlewares[i:]...)...)
+			c.middlewareNames = append(c.middlewareNames[:i], append([]string{""}, c.middlewareNames[i:]...)...)
+			return true
+		}
+	}
+	return false
+}
+
+// ContentTypeInferrer maps a request body value to the Content-Type it should
+// be sent with; returning "" falls back to the built-in inference.
+type ContentTypeInferrer func(body any) string
+
+// SetContentTypeInferrer installs a hook consulted before the built-in
+// Content-Type inference when a request body is set without an explicit
+// header.
+func (c *Client) SetContentTypeInferrer(infer ContentTypeInferrer) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.contentTypeInferrer = infer
+	return c
+}
+
+// getContentTypeInferrer returns the inference hook under the read lock.
+func (c *Client) getContentTypeInferrer() ContentTypeInferrer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.contentTypeInferrer
+}
+
+// BodyTransformer rewrites a response body before it is exposed, returning
+// the replacement body and its content type; returning "" keeps the original
+// content type.
+type BodyTransformer func(contentType string, body []byte) ([]byte, string, error)
+
+// SetBodyTransformer installs a hook applied to every buffered response body
+// before Scan or any other accessor sees it, so envelope encryption (JWE),
+// custom compression, or embedded base64 payloads can be unwrapped
+// transparently. A nil transformer removes the hook.
+func (c *Client) SetBodyTransformer(transform BodyTransformer) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bodyTransformer = transform
+	return c
+}
+
+// getBodyTransformer returns the body transformer under the read lock.
+func (c *Client) getBodyTransformer() BodyTransformer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.bodyTransformer
+}
+
+// SetStrictDecode makes Scan fail client-wide when response bodies contain
+// fields not present in the target struct; see RequestBuilder.StrictDecode
+// for the per-request variant.
+func (c *Client) SetStrictDecode(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.strictDecode = enabled
+	return c
+}
+
+// getStrictDecode returns the strict decoding mode under the read lock.
+func (c *Client) getStrictDecode() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.strictDecode
+}
+
+// SetFailOnProblem configures whether responses with an RFC 9457 problem
+// details body are returned as errors: Send then yields the decoded
+// *ProblemDetails as the error value, alongside the response for inspection.
+func (c *Client) SetFailOnProblem(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failOnProblem = enabled
+	return c
+}
+
+// shouldFailOnProblem returns the problem details failure mode under the read lock.
+func (c *Client) shouldFailOnProblem() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.failOnProblem
+}
+
+// UseResponseInterceptor appends interceptors that run on the wrapped
+// *Response after each request, in the order they were added.
+func (c *Client) UseResponseInterceptor(interceptors ...ResponseInterceptor) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.responseInterceptors = append(c.responseInterceptors, interceptors...)
+	return c
+}
+
+// getResponseInterceptors returns a copy of the interceptor chain under the read lock.
+func (c *Client) getResponseInterceptors() []ResponseInterceptor {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.responseInterceptors == nil {
+		return nil
+	}
+	interceptors := make([]ResponseInterceptor, len(c.responseInterceptors))
+	copy(interceptors, c.responseInterceptors)
+	return interceptors
+}
+
+// MiddlewareNames returns the names of the middlewares in chain order;
+// anonymous middlewares appear as empty strings.
+func (c *Client) MiddlewareNames() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.syncMiddlewareNames()
+	names := make([]string, len(c.middlewareNames))
+	copy(names, c.middlewareNames)
+	return names
 }
 
 // SetTLSConfig sets the TLS configuration for the client.
@@ -195,6 +608,35 @@ func (c *Client) InsecureSkipVerify() *Client {
 	return c
 }
 
+// SetSNIHost sets the TLS ServerName presented during the handshake, so
+// requests can be sent to an IP or backend while negotiating TLS for a
+// different host name.
+func (c *Client) SetSNIHost(host string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.TLSConfig == nil {
+		c.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	c.TLSConfig.ServerName = host
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{}
+	}
+	if transport, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+		transport.TLSClientConfig = c.TLSConfig
+	} else {
+		c.HTTPClient.Transport = &http.Transport{
+			TLSClientConfig: c.TLSConfig,
+		}
+	}
+
+	return c
+}
+
 // SetHTTPClient sets the HTTP client for the client
 func (c *Client) SetHTTPClient(httpClient *http.Client) {
 	c.mu.Lock()
@@ -287,6 +729,47 @@ func (c *Client) SetDefaultCookieJar(jar *cookiejar.Jar) {
 	c.HTTPClient.Jar = jar
 }
 
+// SetDefaultQueries sets the default query parameters for the client
+func (c *Client) SetDefaultQueries(params url.Values) {
+	for key, values := range params {
+		for _, value := range values {
+			c.AddDefaultQuery(key, value)
+		}
+	}
+}
+
+// SetDefaultQuery sets a default query parameter, replacing existing values
+func (c *Client) SetDefaultQuery(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Queries == nil {
+		c.Queries = url.Values{}
+	}
+	c.Queries.Set(key, value)
+}
+
+// AddDefaultQuery adds a default query parameter
+func (c *Client) AddDefaultQuery(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Queries == nil {
+		c.Queries = url.Values{}
+	}
+	c.Queries.Add(key, value)
+}
+
+// DelDefaultQuery removes a default query parameter
+func (c *Client) DelDefaultQuery(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Queries != nil { // Only attempt to delete if Queries is initialized
+		c.Queries.Del(key)
+	}
+}
+
 // SetDefaultCookies sets the default cookies for the client
 func (c *Client) SetDefaultCookies(cookies map[string]string) {
 	for name, value := range cookies {
@@ -294,15 +777,29 @@ func (c *Client) SetDefaultCookies(cookies map[string]string) {
 	}
 }
 
-// SetDefaultCookie sets a default cookie for the client
+// SetDefaultCookie sets a default cookie for the client, updating an
+// existing cookie with the same name instead of appending a duplicate.
 func (c *Client) SetDefaultCookie(name, value string) {
+	c.SetDefaultCookieFull(&http.Cookie{Name: name, Value: value})
+}
+
+// SetDefaultCookieFull sets a default cookie preserving all attributes
+// (Path, Domain, Expires, Secure, HttpOnly, SameSite, ...), updating an
+// existing cookie with the same name instead of appending a duplicate.
+func (c *Client) SetDefaultCookieFull(cookie *http.Cookie) {
+	if cookie == nil {
+		return
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.Cookies == nil {
-		c.Cookies = make([]*http.Cookie, 0)
+	for i, existing := range c.Cookies {
+		if existing.Name == cookie.Name {
+			c.Cookies[i] = cookie
+			return
+		}
 	}
-	c.Cookies = append(c.Cookies, &http.Cookie{Name: name, Value: value})
+	c.Cookies = append(c.Cookies, cookie)
 }
 
 // DelDefaultCookie removes a default cookie from the client
@@ -322,6 +819,9 @@ func (c *Client) DelDefaultCookie(name string) {
 
 // SetJSONMarshal sets the JSON marshal function for the client's JSONEncoder
 func (c *Client) SetJSONMarshal(marshalFunc func(v any) ([]byte, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.JSONEncoder = &JSONEncoder{
 		MarshalFunc: marshalFunc,
 	}
@@ -329,13 +829,42 @@ func (c *Client) SetJSONMarshal(marshalFunc func(v any) ([]byte, error)) {
 
 // SetJSONUnmarshal sets the JSON unmarshal function for the client's JSONDecoder
 func (c *Client) SetJSONUnmarshal(unmarshalFunc func(data []byte, v any) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.JSONDecoder = &JSONDecoder{
 		UnmarshalFunc: unmarshalFunc,
 	}
 }
 
+// SetJSONDecodeOptions configures JSON decoding behavior: useNumber decodes
+// numbers as json.Number so int64 IDs keep their precision, and
+// disallowUnknownFields errors on body fields absent from the target struct.
+// It replaces any custom unmarshal function set via SetJSONUnmarshal.
+func (c *Client) SetJSONDecodeOptions(useNumber, disallowUnknownFields bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.JSONDecoder = &JSONDecoder{
+		UnmarshalFunc: func(data []byte, v any) error {
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			if useNumber {
+				decoder.UseNumber()
+			}
+			if disallowUnknownFields {
+				decoder.DisallowUnknownFields()
+			}
+			return decoder.Decode(v)
+		},
+	}
+	return c
+}
+
 // SetXMLMarshal sets the XML marshal function for the client's XMLEncoder
 func (c *Client) SetXMLMarshal(marshalFunc func(v any) ([]byte, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.XMLEncoder = &XMLEncoder{
 		MarshalFunc: marshalFunc,
 	}
@@ -343,6 +872,9 @@ func (c *Client) SetXMLMarshal(marshalFunc func(v any) ([]byte, error)) {
 
 // SetXMLUnmarshal sets the XML unmarshal function for the client's XMLDecoder
 func (c *Client) SetXMLUnmarshal(unmarshalFunc func(data []byte, v any) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.XMLDecoder = &XMLDecoder{
 		UnmarshalFunc: unmarshalFunc,
 	}
@@ -350,6 +882,9 @@ func (c *Client) SetXMLUnmarshal(unmarshalFunc func(data []byte, v any) error) {
 
 // SetYAMLMarshal sets the YAML marshal function for the client's YAMLEncoder
 func (c *Client) SetYAMLMarshal(marshalFunc func(v any) ([]byte, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.YAMLEncoder = &YAMLEncoder{
 		MarshalFunc: marshalFunc,
 	}
@@ -357,6 +892,9 @@ func (c *Client) SetYAMLMarshal(marshalFunc func(v any) ([]byte, error)) {
 
 // SetYAMLUnmarshal sets the YAML unmarshal function for the client's YAMLDecoder
 func (c *Client) SetYAMLUnmarshal(unmarshalFunc func(data []byte, v any) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.YAMLDecoder = &YAMLDecoder{
 		UnmarshalFunc: unmarshalFunc,
 	}
@@ -389,13 +927,112 @@ func (c *Client) SetRetryIf(retryIf RetryIfFunc) *Client {
 	return c
 }
 
+// OnRetry sets a hook invoked before each retry wait.
+func (c *Client) OnRetry(hook RetryHook) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onRetry = hook
+	return c
+}
+
+// SetBackoffFromResponse sets a hook that can override the computed retry
+// delay based on the failed response, e.g. to honor Retry-After or
+// X-RateLimit-Reset headers via RetryAfterBackoff.
+func (c *Client) SetBackoffFromResponse(hook BackoffFromResponse) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.backoffFromResponse = hook
+	return c
+}
+
+// getBackoffFromResponse returns the response backoff hook under the read lock.
+func (c *Client) getBackoffFromResponse() BackoffFromResponse {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.backoffFromResponse
+}
+
+// SetRejectGetBody escalates the body-on-GET/HEAD warning to a validation
+// error (ErrBodyNotAllowed), for codebases where such a request is always a
+// bug. Individual requests can still opt out with AllowGetBody.
+func (c *Client) SetRejectGetBody(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rejectGetBody = enabled
+	return c
+}
+
+// rejectsGetBody reports whether bodies on GET/HEAD fail validation.
+func (c *Client) rejectsGetBody() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.rejectGetBody
+}
+
 // SetAuth configures an authentication method for the client.
 func (c *Client) SetAuth(auth AuthMethod) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if auth.Valid() {
 		c.auth = auth
 	}
 }
 
+// SetHostAuth configures an authentication method applied only to requests
+// for the given host (hostname with optional port). Host-specific auth takes
+// precedence over the client-wide auth but not over per-request Auth.
+func (c *Client) SetHostAuth(host string, auth AuthMethod) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if auth != nil && auth.Valid() {
+		if c.hostAuth == nil {
+			c.hostAuth = make(map[string]AuthMethod)
+		}
+		c.hostAuth[strings.ToLower(host)] = auth
+	}
+	return c
+}
+
+// getHostAuth returns the auth method registered for the host, trying the
+// host:port form before the bare hostname, under the read lock.
+func (c *Client) getHostAuth(u *url.URL) AuthMethod {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.hostAuth == nil {
+		return nil
+	}
+	if auth, ok := c.hostAuth[strings.ToLower(u.Host)]; ok {
+		return auth
+	}
+	return c.hostAuth[strings.ToLower(u.Hostname())]
+}
+
+// SetScanFallback sets the format Scan falls back to when the response
+// Content-Type header is absent or too generic to select a decoder.
+func (c *Client) SetScanFallback(format ContentFormat) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.scanFallback = format
+	return c
+}
+
+// getScanFallback returns the scan fallback format under the read lock.
+func (c *Client) getScanFallback() ContentFormat {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.scanFallback
+}
+
 // SetLogger sets logger instance in client.
 func (c *Client) SetLogger(logger Logger) *Client {
 	c.mu.Lock()
@@ -405,6 +1042,59 @@ func (c *Client) SetLogger(logger Logger) *Client {
 	return c
 }
 
+// addCloseHook registers a function run when the client is closed, used by
+// features that spawn background goroutines.
+func (c *Client) addCloseHook(hook func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closeHooks = append(c.closeHooks, hook)
+}
+
+// isClosed reports whether the client has been closed.
+func (c *Client) isClosed() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.closed
+}
+
+// Close shuts the client down: new requests fail with ErrClientClosed,
+// registered background tasks are stopped, idle connections are closed, and
+// in-flight requests are drained until they finish or the context expires.
+func (c *Client) Close(ctx context.Context) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	hooks := c.closeHooks
+	c.closeHooks = nil
+	c.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+
+	c.getHTTPClient().CloseIdleConnections()
+
+	// Drain in-flight requests.
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if c.metrics == nil || c.PoolStats().InFlight == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 // Get initiates a GET request
 func (c *Client) Get(path string) *RequestBuilder {
 	return c.NewRequestBuilder(http.MethodGet, path)
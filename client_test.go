@@ -14,6 +14,7 @@ import (
 	"net/http/cookiejar"
 	"net/http/httptest"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -540,8 +541,12 @@ func TestDelDefaultHeader(t *testing.T) {
 
 func TestSetDefaultContentType(t *testing.T) {
 	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Check the Content-Type header
-		if r.Header.Get("Content-Type") != "application/json" {
+		// The default Content-Type rides along only when a body is present;
+		// bodyless requests drop it.
+		if r.Method == http.MethodGet && r.Header.Get("Content-Type") != "" {
+			t.Error("Default Content-Type should be dropped on bodyless requests")
+		}
+		if r.Method == http.MethodPost && r.Header.Get("Content-Type") != "application/json" {
 			t.Error("Default Content-Type header not set correctly")
 		}
 	}))
@@ -554,6 +559,11 @@ func TestSetDefaultContentType(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to send request: %v", err)
 	}
+
+	_, err = client.Post("/").Body([]byte(`{"name":"alice"}`)).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
 }
 
 func TestSetDefaultAccept(t *testing.T) {
@@ -877,3 +887,116 @@ func TestSetRetryIf(t *testing.T) {
 		t.Errorf("Expected 2 retries, got %d", retryCount)
 	}
 }
+
+// TestConcurrentClientMutation exercises client mutation while requests are in
+// flight; run with -race to verify the send path takes read locks.
+func TestConcurrentClientMutation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func(i int) {
+			defer wg.Done()
+			client.SetDefaultHeader("X-Round", fmt.Sprintf("%d", i))
+			client.SetDefaultCookie("round", fmt.Sprintf("%d", i))
+			client.SetMaxRetries(i % 3)
+		}(i)
+
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get("/").Send(context.Background())
+			if err != nil {
+				t.Errorf("Failed to send request: %v", err)
+				return
+			}
+			defer resp.Close() //nolint: errcheck
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDefaultQueryParameters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetDefaultQuery("api_key", "secret")
+	client.SetDefaultQuery("version", "v1")
+
+	// Defaults are applied to every request.
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "api_key=secret&version=v1", resp.String())
+
+	// Request-level values override defaults and DelQuery removes them.
+	resp, err = client.Get("/").
+		SetQuery("version", "v2").
+		DelQuery("api_key").
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "version=v2", resp.String())
+
+	// Deleting the default removes it for subsequent requests.
+	client.DelDefaultQuery("api_key")
+	resp, err = client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "version=v1", resp.String())
+}
+
+func TestSetSNIHost(t *testing.T) {
+	client := Create(&Config{})
+	client.SetSNIHost("fronted.example.com")
+
+	assert.Equal(t, "fronted.example.com", client.TLSConfig.ServerName)
+
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	assert.True(t, ok)
+	assert.Equal(t, "fronted.example.com", transport.TLSClientConfig.ServerName)
+}
+
+func TestClientClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	hookRan := false
+	client.addCloseHook(func() { hookRan = true })
+
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	err = client.Close(context.Background())
+	require.NoError(t, err)
+	assert.True(t, hookRan)
+
+	// New requests fail once the client is closed.
+	_, err = client.Get("/").Send(context.Background())
+	assert.ErrorIs(t, err, ErrClientClosed)
+
+	// Closing again is a no-op.
+	require.NoError(t, client.Close(context.Background()))
+}
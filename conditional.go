@@ -0,0 +1,84 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultConflictAttempts bounds the optimistic-concurrency retry loop when
+// the caller does not pick a limit.
+const defaultConflictAttempts = 3
+
+// IfMatch sets the If-Match header for a conditional update, quoting the
+// entity tag when needed so callers can pass ETag values as received.
+func (b *RequestBuilder) IfMatch(etag string) *RequestBuilder {
+	return b.Header("If-Match", quoteETag(etag))
+}
+
+// IfNoneMatch sets the If-None-Match header, the counterpart used for
+// create-if-absent and cache validation requests.
+func (b *RequestBuilder) IfNoneMatch(etag string) *RequestBuilder {
+	return b.Header("If-None-Match", quoteETag(etag))
+}
+
+// quoteETag wraps a bare entity tag in double quotes; "*", quoted, and weak
+// (W/"...") tags pass through unchanged.
+func quoteETag(etag string) string {
+	if etag == "*" || strings.HasPrefix(etag, `"`) || strings.HasPrefix(etag, `W/"`) {
+		return etag
+	}
+	return `"` + etag + `"`
+}
+
+// UpdateWithRetryOnConflict runs the optimistic-concurrency loop every REST
+// client ends up writing by hand: fetch the current resource, build an update
+// from it, and send the update conditioned on the fetched ETag via If-Match.
+// When the server answers 409 or 412 — someone else won the race — the flow
+// backs off and re-fetches, up to maxAttempts times (0 means 3). mutate
+// receives the fetched response and returns the update request; the fetched
+// ETag is applied automatically unless mutate already set If-Match.
+func (c *Client) UpdateWithRetryOnConflict(ctx context.Context, fetch func(ctx context.Context) (*Response, error), mutate func(current *Response) (*RequestBuilder, error), maxAttempts int) (*Response, error) {
+	if maxAttempts < 1 {
+		maxAttempts = defaultConflictAttempts
+	}
+	_, backoff, _ := c.getRetryConfig()
+
+	for attempt := 1; ; attempt++ {
+		current, err := fetch(ctx)
+		if err != nil {
+			return current, err
+		}
+
+		builder, err := mutate(current)
+		etag := current.Header().Get("ETag")
+		_ = current.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if etag != "" && builder.headers.Get("If-Match") == "" {
+			builder.IfMatch(etag)
+		}
+
+		resp, err := builder.Send(ctx)
+		if err != nil {
+			return resp, err
+		}
+		if resp.StatusCode() != http.StatusConflict && resp.StatusCode() != http.StatusPreconditionFailed {
+			return resp, nil
+		}
+		_ = resp.Close()
+
+		if attempt >= maxAttempts {
+			return nil, fmt.Errorf("%w: %d attempts", ErrConflictNotResolved, attempt)
+		}
+		select {
+		case <-time.After(backoff(attempt)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIfMatchQuoting(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Put("/doc").IfMatch("abc123").Build(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `"abc123"`, req.Header.Get("If-Match"))
+
+	req, err = client.Put("/doc").IfMatch(`W/"weak"`).Build(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `W/"weak"`, req.Header.Get("If-Match"))
+
+	req, err = client.Put("/doc").IfMatch("*").Build(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "*", req.Header.Get("If-Match"))
+}
+
+// versionedResource is a tiny optimistic-concurrency server: updates must
+// present the current version's ETag.
+type versionedResource struct {
+	mu      sync.Mutex
+	version int
+	stale   int // number of updates to reject before accepting
+}
+
+func (s *versionedResource) handler(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	etag := fmt.Sprintf(`"v%d"`, s.version)
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("ETag", etag)
+		fmt.Fprintf(w, "version %d", s.version)
+	case http.MethodPut:
+		if r.Header.Get("If-Match") != etag || s.stale > 0 {
+			if s.stale > 0 {
+				s.stale--
+				s.version++ // A concurrent writer got there first.
+			}
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		s.version++
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func TestUpdateWithRetryOnConflict(t *testing.T) {
+	resource := &versionedResource{stale: 1}
+	mockServer := httptest.NewServer(http.HandlerFunc(resource.handler))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetRetryStrategy(LinearBackoffStrategy(time.Millisecond))
+
+	var mutations int
+	resp, err := client.UpdateWithRetryOnConflict(context.Background(),
+		func(ctx context.Context) (*Response, error) {
+			return client.Get("/doc").Send(ctx)
+		},
+		func(current *Response) (*RequestBuilder, error) {
+			mutations++
+			return client.Put("/doc").Body(current.String() + " updated"), nil
+		},
+		3)
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.True(t, resp.IsSuccess())
+	assert.Equal(t, 2, mutations, "the first update lost the race and was retried")
+}
+
+func TestUpdateWithRetryOnConflictExhausted(t *testing.T) {
+	resource := &versionedResource{stale: 10}
+	mockServer := httptest.NewServer(http.HandlerFunc(resource.handler))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetRetryStrategy(LinearBackoffStrategy(time.Millisecond))
+
+	_, err := client.UpdateWithRetryOnConflict(context.Background(),
+		func(ctx context.Context) (*Response, error) {
+			return client.Get("/doc").Send(ctx)
+		},
+		func(current *Response) (*RequestBuilder, error) {
+			return client.Put("/doc").Body("update"), nil
+		},
+		2)
+	require.ErrorIs(t, err, ErrConflictNotResolved)
+}
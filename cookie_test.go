@@ -0,0 +1,88 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDefaultCookieUpdatesInPlace(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	client.SetDefaultCookie("session", "first")
+	client.SetDefaultCookie("session", "second")
+	client.SetDefaultCookie("other", "x")
+
+	require.Len(t, client.Cookies, 2)
+	assert.Equal(t, "second", client.Cookies[0].Value)
+}
+
+func TestSetDefaultCookieFullPreservesAttributes(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	expires := time.Now().Add(time.Hour)
+	client.SetDefaultCookieFull(&http.Cookie{
+		Name:     "session",
+		Value:    "abc",
+		Path:     "/api",
+		Domain:   "example.com",
+		Expires:  expires,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	require.Len(t, client.Cookies, 1)
+	cookie := client.Cookies[0]
+	assert.Equal(t, "/api", cookie.Path)
+	assert.Equal(t, "example.com", cookie.Domain)
+	assert.True(t, cookie.Secure)
+	assert.True(t, cookie.HttpOnly)
+	assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+}
+
+func TestCookieFullOnRequest(t *testing.T) {
+	var got string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("session"); err == nil {
+			got = cookie.Value
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").
+		CookieFull(&http.Cookie{Name: "session", Value: "abc", Path: "/"}).
+		Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, "abc", got)
+}
+
+func TestSecureAndExpiredCookiesWithheld(t *testing.T) {
+	var names []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names = nil
+		for _, cookie := range r.Cookies() {
+			names = append(names, cookie.Name)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetDefaultCookieFull(&http.Cookie{Name: "secure-only", Value: "x", Secure: true})
+	client.SetDefaultCookieFull(&http.Cookie{Name: "expired", Value: "x", Expires: time.Now().Add(-time.Hour)})
+	client.SetDefaultCookieFull(&http.Cookie{Name: "plain", Value: "x"})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, []string{"plain"}, names, "secure cookies stay off plain HTTP and expired cookies are dropped")
+}
@@ -0,0 +1,69 @@
+package requests
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultClient backs the package-level convenience functions, mirroring
+// net/http's DefaultClient so scripts and small tools can use the package
+// without constructing a Client.
+var (
+	defaultClientMu sync.RWMutex
+	defaultClient   = Create(nil)
+)
+
+// DefaultClient returns the client backing the package-level functions.
+func DefaultClient() *Client {
+	defaultClientMu.RLock()
+	defer defaultClientMu.RUnlock()
+
+	return defaultClient
+}
+
+// SetDefaultClient replaces the client backing the package-level functions,
+// e.g. to configure retries or auth for a whole program. A nil client is
+// ignored.
+func SetDefaultClient(client *Client) {
+	if client == nil {
+		return
+	}
+	defaultClientMu.Lock()
+	defer defaultClientMu.Unlock()
+
+	defaultClient = client
+}
+
+// Get sends a GET request to the URL using the default client.
+func Get(ctx context.Context, url string) (*Response, error) {
+	return DefaultClient().Get(url).Send(ctx)
+}
+
+// Post sends a POST request to the URL using the default client.
+func Post(ctx context.Context, url string, body interface{}) (*Response, error) {
+	return DefaultClient().Post(url).Body(body).Send(ctx)
+}
+
+// GetJSON sends a GET request to the URL using the default client and decodes
+// the response into out. See Client.GetJSON for status and decode semantics.
+func GetJSON(ctx context.Context, url string, out interface{}) (*Response, error) {
+	return DefaultClient().GetJSON(ctx, url, out)
+}
+
+// PostJSON sends a POST request with in as the JSON body using the default
+// client and decodes the response into out.
+func PostJSON(ctx context.Context, url string, in, out interface{}) (*Response, error) {
+	return DefaultClient().PostJSON(ctx, url, in, out)
+}
+
+// PutJSON sends a PUT request with in as the JSON body using the default
+// client and decodes the response into out.
+func PutJSON(ctx context.Context, url string, in, out interface{}) (*Response, error) {
+	return DefaultClient().PutJSON(ctx, url, in, out)
+}
+
+// DeleteJSON sends a DELETE request to the URL using the default client and
+// decodes the response into out.
+func DeleteJSON(ctx context.Context, url string, out interface{}) (*Response, error) {
+	return DefaultClient().DeleteJSON(ctx, url, out)
+}
@@ -0,0 +1,62 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageLevelGet(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer mockServer.Close()
+
+	resp, err := Get(context.Background(), mockServer.URL+"/greeting")
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+	assert.Equal(t, "hello", resp.String())
+}
+
+func TestPackageLevelPostJSON(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer mockServer.Close()
+
+	var out struct {
+		OK bool `json:"ok"`
+	}
+	resp, err := PostJSON(context.Background(), mockServer.URL+"/items", map[string]string{"name": "x"}, &out)
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+	assert.True(t, out.OK)
+}
+
+func TestSetDefaultClient(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("X-Custom")))
+	}))
+	defer mockServer.Close()
+
+	original := DefaultClient()
+	defer SetDefaultClient(original)
+
+	custom := Create(nil)
+	custom.SetDefaultHeader("X-Custom", "configured")
+	SetDefaultClient(custom)
+	assert.Same(t, custom, DefaultClient())
+
+	resp, err := Get(context.Background(), mockServer.URL)
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+	assert.Equal(t, "configured", resp.String())
+
+	SetDefaultClient(nil)
+	assert.Same(t, custom, DefaultClient(), "nil client is ignored")
+}
@@ -0,0 +1,95 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Filename returns the file name the server suggests for this response via
+// the Content-Disposition header (RFC 6266), preferring the RFC 5987
+// filename* form when present. The name is reduced to its base component so
+// a hostile header cannot point outside the target directory; an empty
+// string means no usable suggestion.
+func (r *Response) Filename() string {
+	disposition := r.Header().Get("Content-Disposition")
+	if disposition == "" {
+		return ""
+	}
+	_, params, err := mime.ParseMediaType(disposition)
+	if err != nil {
+		return ""
+	}
+	return sanitizeFilename(params["filename"])
+}
+
+// sanitizeFilename strips directory components from a server-supplied name,
+// guarding against path traversal. Both slash styles are treated as
+// separators since the header may come from any platform.
+func sanitizeFilename(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = path.Base(name)
+	if name == "." || name == ".." || name == "/" || strings.ContainsRune(name, 0) {
+		return ""
+	}
+	return name
+}
+
+// filenameFromURL falls back to the last URL path segment when the server
+// suggests no name.
+func filenameFromURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	return sanitizeFilename(path.Base(u.Path))
+}
+
+// SaveToDir writes the body into dir under the server-suggested file name,
+// falling back to the request URL's last path segment and then "download".
+// It returns the path written. Suggested names are sanitized, so the file
+// always lands inside dir.
+func (r *Response) SaveToDir(dir string) (string, error) {
+	name := r.Filename()
+	if name == "" && r.RawResponse != nil && r.RawResponse.Request != nil {
+		name = filenameFromURL(r.RawResponse.Request.URL)
+	}
+	if name == "" {
+		name = "download"
+	}
+
+	target := filepath.Join(dir, name)
+	if err := r.Save(target); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+// DownloadFile fetches rawURL and writes the body to path, returning the
+// path written. An empty path saves into the current directory, and a path
+// naming an existing directory saves into it — both using the
+// server-suggested name from Content-Disposition with the same fallbacks as
+// SaveToDir. Any other path is used as the target file name directly.
+func (c *Client) DownloadFile(ctx context.Context, rawURL, path string) (string, error) {
+	resp, err := c.Get(rawURL).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Close() //nolint:errcheck
+
+	if !resp.IsSuccess() {
+		return "", fmt.Errorf("%w: %s", ErrDownloadFailed, resp.Status())
+	}
+
+	if path == "" {
+		return resp.SaveToDir(".")
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return resp.SaveToDir(path)
+	}
+	return path, resp.Save(path)
+}
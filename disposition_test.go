@@ -0,0 +1,105 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dispositionServer(header, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if header != "" {
+			w.Header().Set("Content-Disposition", header)
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestFilenameFromContentDisposition(t *testing.T) {
+	tests := []struct {
+		name        string
+		disposition string
+		want        string
+	}{
+		{"plain", `attachment; filename="report.pdf"`, "report.pdf"},
+		{"unquoted", `attachment; filename=data.csv`, "data.csv"},
+		{"extended", `attachment; filename*=UTF-8''na%C3%AFve%20file.txt`, "naïve file.txt"},
+		{"traversal", `attachment; filename="../../etc/passwd"`, "passwd"},
+		{"backslash traversal", `attachment; filename="..\..\boot.ini"`, "boot.ini"},
+		{"dot dot only", `attachment; filename=".."`, ""},
+		{"missing", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := dispositionServer(tt.disposition, "content")
+			defer server.Close()
+
+			resp, err := Create(&Config{BaseURL: server.URL}).Get("/file").Send(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.Filename())
+		})
+	}
+}
+
+func TestDownloadFileUsesSuggestedName(t *testing.T) {
+	server := dispositionServer(`attachment; filename="export.csv"`, "a,b,c")
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := Create(&Config{BaseURL: server.URL})
+
+	written, err := client.DownloadFile(context.Background(), "/reports/latest", dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "export.csv"), written)
+
+	data, err := os.ReadFile(written)
+	require.NoError(t, err)
+	assert.Equal(t, "a,b,c", string(data))
+}
+
+func TestDownloadFileFallsBackToURLName(t *testing.T) {
+	server := dispositionServer("", "payload")
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := Create(&Config{BaseURL: server.URL})
+
+	written, err := client.DownloadFile(context.Background(), "/files/archive.tar.gz", dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "archive.tar.gz"), written)
+}
+
+func TestDownloadFileExplicitPath(t *testing.T) {
+	server := dispositionServer(`attachment; filename="ignored.bin"`, "data")
+	defer server.Close()
+
+	target := filepath.Join(t.TempDir(), "chosen.bin")
+	client := Create(&Config{BaseURL: server.URL})
+
+	written, err := client.DownloadFile(context.Background(), "/file", target)
+	require.NoError(t, err)
+	assert.Equal(t, target, written)
+
+	data, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+}
+
+func TestDownloadFileNonSuccessStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	_, err := client.DownloadFile(context.Background(), "/missing", t.TempDir())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrDownloadFailed)
+}
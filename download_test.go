@@ -0,0 +1,85 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadParallel(t *testing.T) {
+	payload := bytes.Repeat([]byte("0123456789abcdef"), 4096)
+
+	var rangeRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" {
+			atomic.AddInt32(&rangeRequests, 1)
+		}
+		http.ServeContent(w, r, "artifact.bin", time.Time{}, bytes.NewReader(payload))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "artifact.bin")
+
+	client := Create(&Config{})
+	require.NoError(t, client.DownloadParallel(context.Background(), server.URL, path, 4))
+
+	downloaded, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, payload, downloaded)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&rangeRequests))
+
+	// The temp file is cleaned up after the rename.
+	_, err = os.Stat(path + partialSuffix)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDownloadParallelFallsBackWithoutRanges(t *testing.T) {
+	payload := []byte("small body without range support")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(payload)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fallback.bin")
+
+	client := Create(&Config{})
+	require.NoError(t, client.DownloadParallel(context.Background(), server.URL, path, 4))
+
+	downloaded, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, payload, downloaded)
+}
+
+func TestDownloadParallelResumesFailedParts(t *testing.T) {
+	payload := bytes.Repeat([]byte("resume-me!"), 1024)
+
+	var failures int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first ranged request to force a retry.
+		if r.Header.Get("Range") != "" && atomic.CompareAndSwapInt32(&failures, 0, 1) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		http.ServeContent(w, r, "artifact.bin", time.Time{}, bytes.NewReader(payload))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "resumed.bin")
+
+	client := Create(&Config{MaxRetries: 2, RetryStrategy: DefaultBackoffStrategy(time.Millisecond)})
+	require.NoError(t, client.DownloadParallel(context.Background(), server.URL, path, 2))
+
+	downloaded, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, payload, downloaded)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&failures))
+}
@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"io"
+	"time"
+)
+
+// Draining defaults: how much of an abandoned body is read so the keep-alive
+// connection can be reused, and how long the drain may take before the
+// connection is dropped instead.
+const (
+	defaultDrainBytes   = 64 << 10 // 64 KiB
+	defaultDrainTimeout = 2 * time.Second
+)
+
+// drainAndClose reads up to the drain limit from an abandoned response body
+// before closing it. A body closed with unread data kills the underlying
+// keep-alive connection, so every retry would otherwise pay for a fresh
+// dial; bounded draining keeps the connection reusable. Bodies larger than
+// the limit or slower than the deadline are abandoned — dropping one
+// connection is cheaper than reading a huge or stalled body.
+func (c *Client) drainAndClose(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+
+	limit := c.getLimits().MaxDrainBytes
+	if limit <= 0 {
+		limit = defaultDrainBytes
+	}
+
+	// The body has no deadline API; closing it from a timer unblocks a
+	// stalled read.
+	timer := time.AfterFunc(defaultDrainTimeout, func() {
+		_ = body.Close()
+	})
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, limit))
+	timer.Stop()
+	_ = body.Close()
+}
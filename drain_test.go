@@ -0,0 +1,92 @@
+package requests
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newConnCountingServer returns a server that answers 503 with a body until
+// the final attempt, counting fresh TCP connections.
+func newConnCountingServer(failures int, body string) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	mockServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(calls.Add(1)) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var conns atomic.Int32
+	mockServer.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	mockServer.Start()
+	return mockServer, &conns
+}
+
+func TestRetryDrainsBodyAndReusesConnection(t *testing.T) {
+	mockServer, conns := newConnCountingServer(2, strings.Repeat("e", 1024))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 2})
+	client.SetRetryStrategy(LinearBackoffStrategy(time.Millisecond))
+
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Close() //nolint:errcheck
+
+	if got := resp.Attempts(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+	if got := conns.Load(); got != 1 {
+		t.Fatalf("expected all attempts on 1 connection, got %d", got)
+	}
+}
+
+// BenchmarkRetryConnectionChurn measures connections per retried call; with
+// bounded draining every retry reuses the keep-alive connection.
+func BenchmarkRetryConnectionChurn(b *testing.B) {
+	var calls atomic.Int32
+	mockServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(strings.Repeat("e", 1024)))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	var conns atomic.Int32
+	mockServer.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	mockServer.Start()
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 1})
+	client.SetRetryStrategy(LinearBackoffStrategy(0))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = resp.Close()
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(conns.Load())/float64(b.N), "conns/op")
+}
@@ -0,0 +1,13 @@
+// Package encjson groups optional JSON codec adapters for the requests
+// client. Each subpackage plugs a high-performance encoder into
+// Client.SetJSONMarshal and Client.SetJSONUnmarshal behind a build tag, so
+// the extra dependency is only pulled in when the tag is enabled:
+//
+//	sonic    — github.com/bytedance/sonic   (build tag "sonic")
+//	jsoniter — github.com/json-iterator/go  (build tag "jsoniter")
+//	gojson   — github.com/goccy/go-json     (build tag "gojson")
+//
+// The adapters use each library's standard-library-compatible configuration,
+// so switching encoders does not change escaping or number formatting on the
+// wire.
+package encjson
@@ -0,0 +1,9 @@
+// Package gojson adapts github.com/goccy/go-json as the JSON codec for a
+// requests client. It is compiled only with the "gojson" build tag:
+//
+//	go get github.com/goccy/go-json
+//	go build -tags gojson
+//
+// goccy/go-json is a drop-in replacement for encoding/json, so escaping and
+// number formatting are unchanged.
+package gojson
@@ -0,0 +1,17 @@
+//go:build gojson
+
+package gojson
+
+import (
+	gojson "github.com/goccy/go-json"
+
+	"github.com/kaptinlin/requests"
+)
+
+// Apply installs goccy/go-json as the client's JSON marshal and unmarshal
+// functions. The client's encoder and decoder keep handling the stream
+// plumbing, so buffered and unbuffered bodies both work unchanged.
+func Apply(client *requests.Client) {
+	client.SetJSONMarshal(gojson.Marshal)
+	client.SetJSONUnmarshal(gojson.Unmarshal)
+}
@@ -0,0 +1,9 @@
+// Package jsoniter adapts github.com/json-iterator/go as the JSON codec for
+// a requests client. It is compiled only with the "jsoniter" build tag:
+//
+//	go get github.com/json-iterator/go
+//	go build -tags jsoniter
+//
+// Apply uses ConfigCompatibleWithStandardLibrary so escaping and number
+// formatting match encoding/json exactly.
+package jsoniter
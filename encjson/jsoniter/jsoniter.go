@@ -0,0 +1,18 @@
+//go:build jsoniter
+
+package jsoniter
+
+import (
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/kaptinlin/requests"
+)
+
+// Apply installs json-iterator as the client's JSON marshal and unmarshal
+// functions. The client's encoder and decoder keep handling the stream
+// plumbing, so buffered and unbuffered bodies both work unchanged.
+func Apply(client *requests.Client) {
+	api := jsoniter.ConfigCompatibleWithStandardLibrary
+	client.SetJSONMarshal(api.Marshal)
+	client.SetJSONUnmarshal(api.Unmarshal)
+}
@@ -0,0 +1,9 @@
+// Package sonic adapts github.com/bytedance/sonic as the JSON codec for a
+// requests client. It is compiled only with the "sonic" build tag:
+//
+//	go get github.com/bytedance/sonic
+//	go build -tags sonic
+//
+// Apply uses sonic's ConfigStd so escaping and number formatting match
+// encoding/json exactly.
+package sonic
@@ -0,0 +1,17 @@
+//go:build sonic
+
+package sonic
+
+import (
+	"github.com/bytedance/sonic"
+
+	"github.com/kaptinlin/requests"
+)
+
+// Apply installs sonic as the client's JSON marshal and unmarshal functions.
+// The client's encoder and decoder keep handling the stream plumbing, so
+// buffered and unbuffered bodies both work unchanged.
+func Apply(client *requests.Client) {
+	client.SetJSONMarshal(sonic.ConfigStd.Marshal)
+	client.SetJSONUnmarshal(sonic.ConfigStd.Unmarshal)
+}
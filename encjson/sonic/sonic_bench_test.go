@@ -0,0 +1,63 @@
+//go:build sonic
+
+package sonic
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/kaptinlin/requests"
+)
+
+type benchPayload struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name"`
+	Email  string   `json:"email"`
+	Tags   []string `json:"tags"`
+	Active bool     `json:"active"`
+}
+
+var benchValue = benchPayload{
+	ID:     42,
+	Name:   "alice",
+	Email:  "alice@example.com",
+	Tags:   []string{"a", "b", "c"},
+	Active: true,
+}
+
+func BenchmarkEncode(b *testing.B) {
+	client := requests.Create(nil)
+	Apply(client)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		reader, err := client.JSONEncoder.Encode(benchValue)
+		if err != nil {
+			b.Fatal(err)
+		}
+		_, _ = io.Copy(io.Discard, reader)
+		if closer, ok := reader.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	client := requests.Create(nil)
+	Apply(client)
+
+	raw, err := json.Marshal(benchValue)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out benchPayload
+		if err := client.JSONDecoder.Decode(bytes.NewReader(raw), &out); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
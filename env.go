@@ -0,0 +1,129 @@
+package requests
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Environment variables recognized by NewFromEnv and Config.FromEnv.
+const (
+	envBaseURL      = "REQUESTS_BASE_URL"
+	envProxy        = "REQUESTS_PROXY"
+	envTimeout      = "REQUESTS_TIMEOUT"
+	envHeaderPrefix = "REQUESTS_HEADERS_"
+)
+
+// FromEnv fills unset fields of the config from REQUESTS_* environment
+// variables: REQUESTS_BASE_URL, REQUESTS_TIMEOUT (a time.ParseDuration
+// string), and REQUESTS_HEADERS_* where the rest of the variable name maps to
+// a header name with underscores as dashes (REQUESTS_HEADERS_ACCEPT_LANGUAGE
+// sets Accept-Language). Explicitly set fields win over the environment.
+func (config *Config) FromEnv() error {
+	if config.BaseURL == "" {
+		config.BaseURL = os.Getenv(envBaseURL)
+	}
+
+	if config.Timeout == 0 {
+		if raw := os.Getenv(envTimeout); raw != "" {
+			timeout, err := time.ParseDuration(raw)
+			if err != nil {
+				return fmt.Errorf("%w: invalid %s: %v", ErrInvalidConfigValue, envTimeout, err) //nolint:errorlint
+			}
+			config.Timeout = timeout
+		}
+	}
+
+	for _, entry := range os.Environ() {
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok || !strings.HasPrefix(name, envHeaderPrefix) {
+			continue
+		}
+		key := strings.ReplaceAll(strings.TrimPrefix(name, envHeaderPrefix), "_", "-")
+		if key == "" {
+			continue
+		}
+		if config.Headers == nil {
+			config.Headers = &http.Header{}
+		}
+		if config.Headers.Get(key) == "" {
+			config.Headers.Set(key, value)
+		}
+	}
+
+	return nil
+}
+
+// NewFromEnv creates a client configured from REQUESTS_* environment
+// variables (see Config.FromEnv), including REQUESTS_PROXY for the proxy URL,
+// so CLI tools built on this package are configurable without code changes.
+func NewFromEnv() (*Client, error) {
+	config := &Config{}
+	if err := config.FromEnv(); err != nil {
+		return nil, err
+	}
+
+	client := Create(config)
+	if proxyURL := os.Getenv(envProxy); proxyURL != "" {
+		if err := client.SetProxy(proxyURL); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// fileConfig is the YAML shape accepted by NewFromConfigFile.
+type fileConfig struct {
+	BaseURL    string            `yaml:"base_url"`
+	Proxy      string            `yaml:"proxy"`
+	Timeout    string            `yaml:"timeout"`
+	Headers    map[string]string `yaml:"headers"`
+	MaxRetries int               `yaml:"max_retries"`
+}
+
+// NewFromConfigFile creates a client from a YAML config file with the keys
+// base_url, proxy, timeout (a time.ParseDuration string), headers (a string
+// map), and max_retries. Environment variables are not consulted; combine
+// with Config.FromEnv by hand if both sources are needed.
+func NewFromConfigFile(path string) (*Client, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed fileConfig
+	if err := yaml.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidConfigValue, err) //nolint:errorlint
+	}
+
+	config := &Config{
+		BaseURL:    parsed.BaseURL,
+		MaxRetries: parsed.MaxRetries,
+	}
+	if parsed.Timeout != "" {
+		timeout, err := time.ParseDuration(parsed.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid timeout: %v", ErrInvalidConfigValue, err) //nolint:errorlint
+		}
+		config.Timeout = timeout
+	}
+	if len(parsed.Headers) > 0 {
+		headers := http.Header{}
+		for key, value := range parsed.Headers {
+			headers.Set(key, value)
+		}
+		config.Headers = &headers
+	}
+
+	client := Create(config)
+	if parsed.Proxy != "" {
+		if err := client.SetProxy(parsed.Proxy); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
@@ -0,0 +1,69 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromEnv(t *testing.T) {
+	var got http.Header
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer mockServer.Close()
+
+	t.Setenv("REQUESTS_BASE_URL", mockServer.URL)
+	t.Setenv("REQUESTS_TIMEOUT", "30s")
+	t.Setenv("REQUESTS_HEADERS_ACCEPT_LANGUAGE", "de-DE")
+	t.Setenv("REQUESTS_HEADERS_X_API_KEY", "secret")
+
+	client, err := NewFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, mockServer.URL, client.BaseURL)
+	assert.Equal(t, 30*time.Second, client.HTTPClient.Timeout)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+	assert.Equal(t, "de-DE", got.Get("Accept-Language"))
+	assert.Equal(t, "secret", got.Get("X-Api-Key"))
+}
+
+func TestConfigFromEnvPrecedence(t *testing.T) {
+	t.Setenv("REQUESTS_BASE_URL", "http://from-env.example")
+	t.Setenv("REQUESTS_TIMEOUT", "not-a-duration")
+
+	// Explicit values win and a bad env duration is only an error when used.
+	config := &Config{BaseURL: "http://explicit.example", Timeout: time.Second}
+	require.NoError(t, config.FromEnv())
+	assert.Equal(t, "http://explicit.example", config.BaseURL)
+	assert.Equal(t, time.Second, config.Timeout)
+
+	config = &Config{}
+	err := config.FromEnv()
+	assert.ErrorIs(t, err, ErrInvalidConfigValue)
+}
+
+func TestNewFromConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requests.yaml")
+	content := "base_url: http://config.example\ntimeout: 5s\nmax_retries: 2\nheaders:\n  Accept: application/json\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	client, err := NewFromConfigFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "http://config.example", client.BaseURL)
+	assert.Equal(t, 5*time.Second, client.HTTPClient.Timeout)
+	assert.Equal(t, 2, client.MaxRetries)
+	assert.Equal(t, "application/json", client.Headers.Get("Accept"))
+
+	_, err = NewFromConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
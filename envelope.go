@@ -0,0 +1,105 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// responseEnvelope describes the wrapper format used by APIs that envelope
+// payloads as {"code":0,"message":"ok","data":{...}}.
+type responseEnvelope struct {
+	dataField    string
+	codeField    string
+	successCodes map[string]struct{}
+}
+
+// envelopeMessageFields are the member names probed for a human-readable
+// message when an enveloped response carries a failure code.
+var envelopeMessageFields = []string{"message", "msg", "error"}
+
+// APIError is returned when an enveloped response carries a code outside the
+// configured success set.
+type APIError struct {
+	Code    interface{} // The raw code member from the envelope
+	Message string      // The message member, when present
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("api error (code %v): %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("api error (code %v)", e.Code)
+}
+
+// SetResponseEnvelope configures automatic envelope unwrapping for JSON
+// responses: Scan validates the code member against the success codes and
+// unmarshals only the data member, returning an *APIError when the code
+// indicates failure. Pass an empty dataField to disable unwrapping again.
+func (c *Client) SetResponseEnvelope(dataField, codeField string, successCodes ...interface{}) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if dataField == "" {
+		c.envelope = nil
+		return c
+	}
+
+	codes := make(map[string]struct{}, len(successCodes))
+	for _, code := range successCodes {
+		codes[fmt.Sprint(code)] = struct{}{}
+	}
+	c.envelope = &responseEnvelope{
+		dataField:    dataField,
+		codeField:    codeField,
+		successCodes: codes,
+	}
+	return c
+}
+
+// getResponseEnvelope returns the envelope configuration under the read lock.
+func (c *Client) getResponseEnvelope() *responseEnvelope {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.envelope
+}
+
+// scanEnvelopedJSON validates the envelope of a JSON body and unmarshals its
+// data member into v.
+func (r *Response) scanEnvelopedJSON(envelope *responseEnvelope, v interface{}) error {
+	var raw map[string]json.RawMessage
+	if err := r.Client.JSONDecoder.Decode(bytes.NewReader(r.BodyBytes), &raw); err != nil {
+		return err
+	}
+
+	if envelope.codeField != "" {
+		var code interface{}
+		if rawCode, ok := raw[envelope.codeField]; ok {
+			if err := r.Client.JSONDecoder.Decode(bytes.NewReader(rawCode), &code); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := envelope.successCodes[fmt.Sprint(code)]; !ok {
+			apiErr := &APIError{Code: code}
+			for _, field := range envelopeMessageFields {
+				if rawMessage, ok := raw[field]; ok {
+					var message string
+					if err := r.Client.JSONDecoder.Decode(bytes.NewReader(rawMessage), &message); err == nil {
+						apiErr.Message = message
+						break
+					}
+				}
+			}
+			return apiErr
+		}
+	}
+
+	data, ok := raw[envelope.dataField]
+	if !ok {
+		return nil
+	}
+	return r.Client.JSONDecoder.Decode(bytes.NewReader(data), v)
+}
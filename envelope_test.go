@@ -0,0 +1,83 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanEnvelopedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"message":"ok","data":{"id":7,"name":"alice"}}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetResponseEnvelope("data", "code", 0)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var user struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	require.NoError(t, resp.Scan(&user))
+	assert.Equal(t, 7, user.ID)
+	assert.Equal(t, "alice", user.Name)
+}
+
+func TestScanEnvelopedResponseFailureCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":1002,"message":"quota exceeded","data":null}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetResponseEnvelope("data", "code", 0)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var payload map[string]interface{}
+	err = resp.Scan(&payload)
+	require.Error(t, err)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "1002", fmt.Sprint(apiErr.Code))
+	assert.Equal(t, "quota exceeded", apiErr.Message)
+	assert.Contains(t, apiErr.Error(), "quota exceeded")
+}
+
+func TestScanEnvelopeDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"data":{"id":7}}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetResponseEnvelope("data", "code", 0)
+	client.SetResponseEnvelope("", "", nil)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	// With unwrapping disabled, Scan sees the whole envelope again.
+	var payload map[string]interface{}
+	require.NoError(t, resp.Scan(&payload))
+	assert.Contains(t, payload, "code")
+	assert.Contains(t, payload, "data")
+}
@@ -33,3 +33,107 @@ var ErrInvalidTransportType = errors.New("invalid transport type")
 
 // ErrResponseNil is returned when the response is nil.
 var ErrResponseNil = errors.New("response is nil")
+
+// ErrMissingPathParam is returned when a path template placeholder has no matching param.
+var ErrMissingPathParam = errors.New("missing path param")
+
+// ErrUnusedPathParam is returned when a supplied path param does not appear in the template.
+var ErrUnusedPathParam = errors.New("unused path param")
+
+// ErrStreamIdleTimeout is returned when no stream data arrives within the idle timeout.
+var ErrStreamIdleTimeout = errors.New("stream idle timeout exceeded")
+
+// ErrClientClosed is returned when a request is sent on a closed client.
+var ErrClientClosed = errors.New("client is closed")
+
+// ErrChecksumMismatch is returned when the response body does not match the
+// expected checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ErrRobotsDisallowed is returned when robots.txt disallows fetching a path.
+var ErrRobotsDisallowed = errors.New("disallowed by robots.txt")
+
+// ErrBodyTransformFailed is returned when the configured body transformer fails.
+var ErrBodyTransformFailed = errors.New("body transform failed")
+
+// ErrInvalidConfigValue is returned when an environment or config file value cannot be parsed.
+var ErrInvalidConfigValue = errors.New("invalid config value")
+
+// ErrUnknownHeaderProfile is returned when the header profile name is unknown.
+var ErrUnknownHeaderProfile = errors.New("unknown header profile")
+
+// ErrTooManyRedirects is returned when a request exceeds its redirect limit.
+var ErrTooManyRedirects = errors.New("too many redirects")
+
+// ErrRPCIncomplete is returned when a JSON-RPC batch response is missing
+// entries for some calls.
+var ErrRPCIncomplete = errors.New("incomplete rpc batch response")
+
+// ErrUnexpectedStatus is returned by the one-shot JSON helpers on non-2xx responses.
+var ErrUnexpectedStatus = errors.New("unexpected status code")
+
+// ErrInvalidMethod is returned when the request method is not a valid HTTP token.
+var ErrInvalidMethod = errors.New("invalid request method")
+
+// ErrConflictingBody is returned when a request configures more than one body source.
+var ErrConflictingBody = errors.New("conflicting body configuration")
+
+// ErrDNS is returned when a request fails during name resolution.
+var ErrDNS = errors.New("dns lookup failed")
+
+// ErrConnect is returned when a request fails while dialing the server.
+var ErrConnect = errors.New("connection failed")
+
+// ErrTLSHandshake is returned when a request fails during the TLS handshake.
+var ErrTLSHandshake = errors.New("tls handshake failed")
+
+// ErrResponseHeaderTimeout is returned when the server does not start
+// responding within the timeout.
+var ErrResponseHeaderTimeout = errors.New("timeout awaiting response headers")
+
+// ErrBodyReadTimeout is returned when reading the response body times out.
+var ErrBodyReadTimeout = errors.New("timeout reading response body")
+
+// ErrCookieStoreFailed is returned when the encrypted cookie store cannot be
+// opened, decrypted, or written.
+var ErrCookieStoreFailed = errors.New("cookie store failed")
+
+// ErrNotAcceptable is returned when the response Content-Type matches none of
+// the media types offered via Negotiate.
+var ErrNotAcceptable = errors.New("response content type not acceptable")
+
+// ErrBodyNotAllowed is returned when a body is set on a GET or HEAD request
+// and the client is configured to reject that instead of warning.
+var ErrBodyNotAllowed = errors.New("request body not allowed")
+
+// ErrConflictNotResolved is returned when UpdateWithRetryOnConflict keeps
+// hitting 409/412 responses after exhausting its attempts.
+var ErrConflictNotResolved = errors.New("conflict not resolved")
+
+// ErrBodyTooLarge is returned when a response body exceeds the configured
+// MaxBodyBytes limit.
+var ErrBodyTooLarge = errors.New("response body too large")
+
+// ErrFixtureNotFound is returned in offline mode when no cassette matches
+// the request, or the matching cassette cannot be read.
+var ErrFixtureNotFound = errors.New("fixture not found")
+
+// ErrAuditLogFailed is returned when the audit log destination cannot be
+// opened, rotated, or written.
+var ErrAuditLogFailed = errors.New("audit log failed")
+
+// ErrHeaderProviderFailed is returned when the dynamic header provider
+// fails, aborting the attempt.
+var ErrHeaderProviderFailed = errors.New("header provider failed")
+
+// ErrBodySpillFailed is returned when a large response body cannot be
+// written to or read back from its temporary spill file.
+var ErrBodySpillFailed = errors.New("body spill failed")
+
+// ErrDownloadFailed is returned when DownloadFile receives a non-2xx
+// response.
+var ErrDownloadFailed = errors.New("download failed")
+
+// ErrBodyNotRewindable is returned when a mirror failover would have to
+// re-send a request body that was consumed by the previous attempt.
+var ErrBodyNotRewindable = errors.New("request body not rewindable")
@@ -0,0 +1,43 @@
+package requests
+
+import "errors"
+
+// Fluent builder and client setters return the receiver for chaining and
+// cannot return an error themselves. Instead of logging and carrying on with
+// a silently wrong request, they record the failure here; Validate — which
+// both Send and Build run first — then surfaces everything as one combined
+// error.
+
+// addError records a configuration error raised by a builder method. It is
+// surfaced by Validate on the next Send or Build.
+func (b *RequestBuilder) addError(err error) {
+	b.errs = append(b.errs, err)
+}
+
+// Err returns the errors recorded by builder methods so far, combined, or
+// nil if the builder is clean. Send and Build report them automatically;
+// this accessor lets callers check earlier in a long chain.
+func (b *RequestBuilder) Err() error {
+	return errors.Join(b.errs...)
+}
+
+// Err returns the configuration errors recorded on the client, combined, or
+// nil if there are none. Setters whose error return was discarded (SetProxy
+// and the like) record failures here, and requests built from the client
+// fail with the combined error until ClearErrors is called.
+func (c *Client) Err() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return errors.Join(c.configErrs...)
+}
+
+// ClearErrors discards the client's recorded configuration errors, for
+// callers that handled a setter's error return and chose to continue.
+func (c *Client) ClearErrors() *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.configErrs = nil
+	return c
+}
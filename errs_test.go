@@ -0,0 +1,58 @@
+package requests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormErrorSurfacesAtSend(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	builder := client.Post("/submit").Form(42)
+	require.Error(t, builder.Err())
+
+	_, err := builder.Send(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnsupportedFormFieldsType)
+}
+
+func TestFormFieldsErrorSurfacesAtBuild(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	_, err := client.Post("/submit").FormFields(42).Build(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnsupportedFormFieldsType)
+}
+
+func TestIgnoredSetProxyErrorFailsSend(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	_ = client.SetProxy("ftp://proxy.example.com")
+	require.Error(t, client.Err())
+
+	_, err := client.Get("/").Send(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnsupportedScheme)
+}
+
+func TestClearErrorsRestoresClient(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	_ = client.SetProxy("ftp://proxy.example.com")
+	require.Error(t, client.Err())
+
+	client.ClearErrors()
+	assert.NoError(t, client.Err())
+
+	_, err := client.Get("/").Build(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestCleanBuilderErrIsNil(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	assert.NoError(t, client.Get("/").Query("a", "b").Err())
+}
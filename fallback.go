@@ -0,0 +1,74 @@
+package requests
+
+import (
+	"crypto/tls"
+	"net/http"
+	"strings"
+)
+
+// SetHTTP1Fallback opts in to retrying a request over HTTP/1.1 on a secondary
+// transport when HTTP/2 negotiation fails (e.g. the server's ALPN response
+// does not offer h2). The fallback replays the attempt transparently; which
+// protocol served the response is reported by Response.Proto and
+// Response.UsedHTTP1Fallback.
+func (c *Client) SetHTTP1Fallback(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.http1Fallback = enabled
+	return c
+}
+
+// http1FallbackEnabled reports whether the HTTP/1.1 fallback is opted in.
+func (c *Client) http1FallbackEnabled() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.http1Fallback
+}
+
+// isHTTP2NegotiationError reports whether the error indicates HTTP/2
+// negotiation failed, i.e. the server does not speak h2 over ALPN.
+func isHTTP2NegotiationError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	return strings.Contains(msg, "http2: unexpected ALPN protocol") ||
+		strings.Contains(msg, "tls: server selected unsupported protocol") ||
+		strings.Contains(msg, "tls: no application protocol")
+}
+
+// http1FallbackClient returns a copy of base that uses the lazily built
+// HTTP/1.1-only transport, keeping the redirect policy, cookie jar and
+// timeout of the client performing the call.
+func (c *Client) http1FallbackClient(base *http.Client) *http.Client {
+	clone := *base
+	clone.Transport = c.getHTTP1Transport()
+	return &clone
+}
+
+// getHTTP1Transport returns the secondary transport with HTTP/2 disabled,
+// building it from the primary transport's settings on first use.
+func (c *Client) getHTTP1Transport() http.RoundTripper {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.http1Transport != nil {
+		return c.http1Transport
+	}
+
+	var transport *http.Transport
+	if base, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+		transport = base.Clone()
+	} else {
+		transport = &http.Transport{TLSClientConfig: c.TLSConfig}
+	}
+	transport.ForceAttemptHTTP2 = false
+	// A non-nil empty TLSNextProto map disables HTTP/2 entirely.
+	transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+
+	c.http1Transport = transport
+	return transport
+}
@@ -0,0 +1,61 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsHTTP2NegotiationError(t *testing.T) {
+	assert.True(t, isHTTP2NegotiationError(errors.New(`http2: unexpected ALPN protocol ""; want "h2"`)))
+	assert.True(t, isHTTP2NegotiationError(errors.New("tls: server selected unsupported protocol")))
+	assert.True(t, isHTTP2NegotiationError(errors.New("tls: no application protocol")))
+
+	assert.False(t, isHTTP2NegotiationError(nil))
+	assert.False(t, isHTTP2NegotiationError(errors.New("connection refused")))
+}
+
+// alpnFailTransport simulates a server that never negotiates h2.
+type alpnFailTransport struct {
+	calls atomic.Int32
+}
+
+func (t *alpnFailTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	t.calls.Add(1)
+	return nil, errors.New(`http2: unexpected ALPN protocol ""; want "h2"`)
+}
+
+func TestHTTP1FallbackReplaysRequest(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("served over h1"))
+	}))
+	defer mockServer.Close()
+
+	transport := &alpnFailTransport{}
+	client := Create(&Config{BaseURL: mockServer.URL, Transport: transport})
+	client.SetHTTP1Fallback(true)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err, "the fallback transport should serve the request")
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, "served over h1", resp.String())
+	assert.Equal(t, "HTTP/1.1", resp.Proto())
+	assert.True(t, resp.UsedHTTP1Fallback())
+	assert.Equal(t, 1, resp.Attempts(), "the fallback replay is not a retry-budget attempt")
+}
+
+func TestHTTP1FallbackOptIn(t *testing.T) {
+	transport := &alpnFailTransport{}
+	client := Create(&Config{BaseURL: "https://example.com", Transport: transport})
+
+	_, err := client.Get("/").Send(context.Background())
+	require.Error(t, err, "without opt-in the negotiation error surfaces")
+	assert.Equal(t, int32(1), transport.calls.Load())
+}
@@ -1,9 +1,14 @@
 package requests
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"net/textproto"
 	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 
 	"github.com/google/go-querystring/query"
@@ -11,9 +16,11 @@ import (
 
 // File represents a form file
 type File struct {
-	Name     string        // Form field name
-	FileName string        // File name
-	Content  io.ReadCloser // File content
+	Name        string               // Form field name
+	FileName    string               // File name
+	Content     io.ReadCloser        // File content
+	ContentType string               // Optional per-part Content-Type
+	Header      textproto.MIMEHeader // Optional additional part headers
 }
 
 // SetContent sets the content of the file
@@ -31,6 +38,43 @@ func (f *File) SetName(name string) {
 	f.Name = name
 }
 
+// SetContentType sets the Content-Type of the part
+func (f *File) SetContentType(contentType string) {
+	f.ContentType = contentType
+}
+
+// SetHeader sets a custom MIME header on the part
+func (f *File) SetHeader(key, value string) {
+	if f.Header == nil {
+		f.Header = textproto.MIMEHeader{}
+	}
+	f.Header.Set(key, value)
+}
+
+// FileFromPath creates a form file from a file on disk. The base name of the
+// path is used as the file name.
+func FileFromPath(field, path string) (*File, error) {
+	content, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("opening form file failed: %w", err)
+	}
+
+	return &File{
+		Name:     field,
+		FileName: filepath.Base(path),
+		Content:  content,
+	}, nil
+}
+
+// FileFromBytes creates a form file from an in-memory byte slice.
+func FileFromBytes(field, fileName string, data []byte) *File {
+	return &File{
+		Name:     field,
+		FileName: fileName,
+		Content:  io.NopCloser(bytes.NewReader(data)),
+	}
+}
+
 // parseFormFields parses the given form fields into url.Values.
 func parseFormFields(fields any) (url.Values, error) {
 	switch data := fields.(type) {
@@ -96,6 +140,11 @@ func parseForm(v any) (url.Values, []*File, error) {
 		}
 		return values, files, nil
 	default:
+		// Structs carrying `form` tags are parsed into fields and file parts.
+		if hasFormTags(v) {
+			return parseFormStruct(v)
+		}
+
 		// Attempt to use query.Values for encoding struct types.
 		if values, err := query.Values(v); err == nil {
 			return values, nil, nil
@@ -106,6 +155,162 @@ func parseForm(v any) (url.Values, []*File, error) {
 	}
 }
 
+// hasFormTags reports whether v is a struct (or pointer to one) with at least
+// one field tagged `form`.
+func hasFormTags(v any) bool {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return false
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		if _, ok := rt.Field(i).Tag.Lookup("form"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseFormStruct parses a struct tagged with `form` tags into form fields and
+// file parts. Fields tagged `form:"name,file"` become file parts from a path
+// string, a []byte, an io.Reader, or a *File. Nested structs flatten with
+// dotted names (`parent.child`), and `form:"-"` skips a field.
+func parseFormStruct(v any) (url.Values, []*File, error) {
+	values := make(url.Values)
+	files := make([]*File, 0)
+
+	if err := walkFormStruct(reflect.ValueOf(v), "", values, &files); err != nil {
+		return nil, nil, err
+	}
+	return values, files, nil
+}
+
+// walkFormStruct recursively visits struct fields, appending form fields and
+// file parts under their (possibly prefixed) names.
+func walkFormStruct(rv reflect.Value, prefix string, values url.Values, files *[]*File) error {
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		isFile := false
+		if tag, ok := field.Tag.Lookup("form"); ok {
+			tagName, opts, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+			isFile = opts == "file"
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		value := rv.Field(i)
+		if isFile {
+			file, err := formFileFromValue(name, value)
+			if err != nil {
+				return err
+			}
+			if file != nil {
+				*files = append(*files, file)
+			}
+			continue
+		}
+
+		if err := appendFormValue(name, value, values, files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendFormValue appends a single non-file struct field to the form values,
+// recursing into nested structs.
+func appendFormValue(name string, value reflect.Value, values url.Values, files *[]*File) error {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		if file, ok := value.Interface().(File); ok {
+			file.SetName(name)
+			*files = append(*files, &file)
+			return nil
+		}
+		return walkFormStruct(value, name, values, files)
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			values.Add(name, string(value.Bytes()))
+			return nil
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := appendFormValue(name, value.Index(i), values, files); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		values.Add(name, fmt.Sprint(value.Interface()))
+		return nil
+	}
+}
+
+// formFileFromValue converts a `form:"...,file"` field value into a file part.
+func formFileFromValue(name string, value reflect.Value) (*File, error) {
+	for value.Kind() == reflect.Pointer && value.Type() != reflect.TypeOf((*File)(nil)) {
+		if value.IsNil() {
+			return nil, nil
+		}
+		value = value.Elem()
+	}
+
+	switch v := value.Interface().(type) {
+	case *File:
+		if v == nil {
+			return nil, nil
+		}
+		v.SetName(name)
+		return v, nil
+	case File:
+		v.SetName(name)
+		return &v, nil
+	case string:
+		return FileFromPath(name, v)
+	case []byte:
+		return FileFromBytes(name, name, v), nil
+	case io.ReadCloser:
+		return &File{Name: name, FileName: name, Content: v}, nil
+	case io.Reader:
+		return &File{Name: name, FileName: name, Content: io.NopCloser(v)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedDataType, value.Interface())
+	}
+}
+
 // FormEncoder handles encoding of form data.
 type FormEncoder struct{}
 
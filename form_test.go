@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -143,3 +145,130 @@ func TestDelFile(t *testing.T) {
 	assert.NotContains(t, uploads, "file1", "file1 should have been removed from the uploads")
 	assert.Contains(t, uploads, "file2", "file2 should be present in the uploads")
 }
+
+func TestMultipartPartControl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			t.Errorf("Failed to parse multipart form: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// The file part must carry its custom content type and header.
+		fileHeaders := r.MultipartForm.File["document"]
+		if assert.Len(t, fileHeaders, 1) {
+			assert.Equal(t, "report.csv", fileHeaders[0].Filename)
+			assert.Equal(t, "text/csv", fileHeaders[0].Header.Get("Content-Type"))
+			assert.Equal(t, "attachment-1", fileHeaders[0].Header.Get("X-Part-Id"))
+		}
+
+		// The string part must arrive as a plain value with its own type.
+		assert.Equal(t, `{"inline":true}`, r.FormValue("meta"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	file := FileFromBytes("document", "report.csv", []byte("a,b\n1,2\n"))
+	file.SetContentType("text/csv")
+	file.SetHeader("X-Part-Id", "attachment-1")
+
+	client := Create(&Config{BaseURL: server.URL})
+	resp, err := client.Post("/upload").
+		Files(file).
+		StringPart("meta", `{"inline":true}`, "application/json").
+		SetBoundary("testboundary123").
+		Send(context.Background())
+	assert.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+}
+
+func TestFileFromPath(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "notes.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+	file, err := FileFromPath("upload", path)
+	assert.NoError(t, err)
+	assert.Equal(t, "upload", file.Name)
+	assert.Equal(t, "notes.txt", file.FileName)
+
+	content, err := io.ReadAll(file.Content)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Content.Close())
+	assert.Equal(t, "hello", string(content))
+
+	// Missing files surface an error instead of a nil file.
+	_, err = FileFromPath("upload", filepath.Join(dir, "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestFormStructWithFileTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			t.Errorf("Failed to parse multipart form: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		assert.Equal(t, "Jane", r.FormValue("name"))
+		assert.Equal(t, "GoCity", r.FormValue("address.city"))
+		assert.Equal(t, "1234", r.FormValue("address.postcode"))
+
+		avatars := r.MultipartForm.File["avatar"]
+		if assert.Len(t, avatars, 1) {
+			file, err := avatars[0].Open()
+			assert.NoError(t, err)
+			defer file.Close() //nolint: errcheck
+
+			content, err := io.ReadAll(file)
+			assert.NoError(t, err)
+			assert.Equal(t, "fake-image-bytes", string(content))
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	type address struct {
+		City     string `form:"city"`
+		Postcode string `form:"postcode"`
+	}
+	type profile struct {
+		Name    string  `form:"name"`
+		Avatar  []byte  `form:"avatar,file"`
+		Address address `form:"address"`
+		Skip    string  `form:"-"`
+	}
+
+	client := Create(&Config{BaseURL: server.URL})
+	resp, err := client.Post("/profile").
+		Form(profile{
+			Name:    "Jane",
+			Avatar:  []byte("fake-image-bytes"),
+			Address: address{City: "GoCity", Postcode: "1234"},
+			Skip:    "ignored",
+		}).
+		Send(context.Background())
+	assert.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+}
+
+func TestFormStructFileFromReader(t *testing.T) {
+	fields, files, err := parseForm(struct {
+		Doc io.Reader `form:"doc,file"`
+	}{Doc: strings.NewReader("content")})
+	assert.NoError(t, err)
+	assert.Empty(t, fields)
+	if assert.Len(t, files, 1) {
+		assert.Equal(t, "doc", files[0].Name)
+
+		content, readErr := io.ReadAll(files[0].Content)
+		assert.NoError(t, readErr)
+		assert.Equal(t, "content", string(content))
+	}
+}
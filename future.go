@@ -0,0 +1,100 @@
+package requests
+
+import (
+	"context"
+	"sync"
+)
+
+// Future represents the eventual result of an asynchronous Send. Callbacks
+// registered with Then and Catch run on the goroutine that executed the
+// request, in registration order, after the result is available.
+type Future struct {
+	mu       sync.Mutex
+	done     chan struct{}
+	resp     *Response
+	err      error
+	onResult []func(*Response)
+	onError  []func(error)
+}
+
+// SendAsync executes the request on a new goroutine and returns a Future that
+// resolves when the request completes. Join with Result, Done, or callbacks.
+func (b *RequestBuilder) SendAsync(ctx context.Context) *Future {
+	f := &Future{done: make(chan struct{})}
+	go func() {
+		resp, err := b.Send(ctx)
+		f.complete(resp, err)
+	}()
+	return f
+}
+
+// complete records the result and fires the registered callbacks.
+func (f *Future) complete(resp *Response, err error) {
+	f.mu.Lock()
+	f.resp, f.err = resp, err
+	onResult, onError := f.onResult, f.onError
+	f.onResult, f.onError = nil, nil
+	close(f.done)
+	f.mu.Unlock()
+
+	if err != nil {
+		for _, cb := range onError {
+			cb(err)
+		}
+		return
+	}
+	for _, cb := range onResult {
+		cb(resp)
+	}
+}
+
+// Then registers a callback invoked with the response if the request
+// succeeds. If the future has already resolved successfully, the callback
+// runs immediately on the calling goroutine.
+func (f *Future) Then(cb func(*Response)) *Future {
+	f.mu.Lock()
+	select {
+	case <-f.done:
+		resp, err := f.resp, f.err
+		f.mu.Unlock()
+		if err == nil {
+			cb(resp)
+		}
+	default:
+		f.onResult = append(f.onResult, cb)
+		f.mu.Unlock()
+	}
+	return f
+}
+
+// Catch registers a callback invoked with the error if the request fails.
+// If the future has already resolved with an error, the callback runs
+// immediately on the calling goroutine.
+func (f *Future) Catch(cb func(error)) *Future {
+	f.mu.Lock()
+	select {
+	case <-f.done:
+		err := f.err
+		f.mu.Unlock()
+		if err != nil {
+			cb(err)
+		}
+	default:
+		f.onError = append(f.onError, cb)
+		f.mu.Unlock()
+	}
+	return f
+}
+
+// Done returns a channel closed when the request completes.
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result blocks until the request completes and returns its outcome.
+func (f *Future) Result() (*Response, error) {
+	<-f.done
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.resp, f.err
+}
@@ -0,0 +1,75 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendAsyncResult(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	future := client.Get("/").SendAsync(context.Background())
+
+	resp, err := future.Result()
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, "hello", resp.String())
+
+	select {
+	case <-future.Done():
+	default:
+		t.Error("Expected Done channel to be closed after Result")
+	}
+}
+
+func TestSendAsyncCallbacks(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	thenCalled := make(chan *Response, 1)
+	catchCalled := make(chan error, 1)
+	client.Get("/").SendAsync(context.Background()).
+		Then(func(resp *Response) { thenCalled <- resp }).
+		Catch(func(err error) { catchCalled <- err })
+
+	resp := <-thenCalled
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Empty(t, catchCalled)
+
+	// Callbacks registered after resolution run immediately.
+	future := client.Get("/").SendAsync(context.Background())
+	<-future.Done()
+	ran := false
+	future.Then(func(resp *Response) {
+		ran = true
+		_ = resp.Close()
+	})
+	assert.True(t, ran)
+}
+
+func TestSendAsyncCatch(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://127.0.0.1:0"})
+
+	caught := make(chan error, 1)
+	client.Get("/").SendAsync(context.Background()).
+		Then(func(resp *Response) { t.Error("Expected Then not to run on failure") }).
+		Catch(func(err error) { caught <- err })
+
+	require.Error(t, <-caught)
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// HeaderProvider computes headers at send time, once per attempt, for values
+// that cannot be set statically at client creation: rotating tokens,
+// request-scoped tenant IDs, signed timestamps. The request passed in is the
+// fully assembled attempt, so providers can derive headers from the method,
+// URL, or existing headers. Returned headers replace same-named headers on
+// the request.
+type HeaderProvider func(ctx context.Context, req *http.Request) (http.Header, error)
+
+// SetHeaderProvider installs the provider consulted before every attempt,
+// retries included. Passing nil removes it. A provider error aborts the
+// attempt and surfaces from Send wrapped in ErrHeaderProviderFailed.
+func (c *Client) SetHeaderProvider(provider HeaderProvider) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.headerProvider = provider
+	return c
+}
+
+// getHeaderProvider returns the header provider under the read lock.
+func (c *Client) getHeaderProvider() HeaderProvider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.headerProvider
+}
+
+// applyHeaderProvider evaluates the provider against the attempt request and
+// overlays the returned headers.
+func (b *RequestBuilder) applyHeaderProvider(req *http.Request) error {
+	provider := b.client.getHeaderProvider()
+	if provider == nil {
+		return nil
+	}
+
+	header, err := provider(req.Context(), req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrHeaderProviderFailed, err) //nolint:errorlint
+	}
+	for key, values := range header {
+		req.Header.Del(key)
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderProviderSetsComputedHeaders(t *testing.T) {
+	var seen atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen.Store(r.Header.Get("X-Tenant"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetHeaderProvider(func(_ context.Context, req *http.Request) (http.Header, error) {
+		return http.Header{"X-Tenant": []string{"tenant-" + req.Method}}, nil
+	})
+
+	_, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-GET", seen.Load())
+}
+
+func TestHeaderProviderRunsPerAttempt(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token") != "token-3" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetHeaderProvider(func(ctx context.Context, _ *http.Request) (http.Header, error) {
+		calls.Add(1)
+		return http.Header{"X-Token": []string{fmt.Sprintf("token-%d", AttemptFromContext(ctx))}}, nil
+	})
+
+	resp, err := client.Get("/").MaxRetries(3).Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestHeaderProviderErrorAbortsSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetHeaderProvider(func(context.Context, *http.Request) (http.Header, error) {
+		return nil, errors.New("token service down")
+	})
+
+	_, err := client.Get("/").Send(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrHeaderProviderFailed)
+}
+
+func TestHeaderProviderOverridesStaticHeader(t *testing.T) {
+	var seen atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen.Store(r.Header.Values("X-Token"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetHeaderProvider(func(context.Context, *http.Request) (http.Header, error) {
+		return http.Header{"X-Token": []string{"dynamic"}}, nil
+	})
+
+	_, err := client.Get("/").Header("X-Token", "static").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dynamic"}, seen.Load())
+}
@@ -0,0 +1,101 @@
+package requests
+
+import (
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// HTTP2Settings tunes HTTP/2 behavior for streaming-heavy workloads where
+// the defaults cause head-of-line blocking or slow dead-peer detection.
+type HTTP2Settings struct {
+	// MaxHeaderListSize caps the advertised header list size in bytes.
+	MaxHeaderListSize uint32
+	// MaxReadFrameSize sets the largest frame the client is willing to read.
+	MaxReadFrameSize uint32
+	// ReadIdleTimeout enables health-check pings when the connection has
+	// seen no frames for this long.
+	ReadIdleTimeout time.Duration
+	// PingTimeout is how long to wait for a ping response before closing
+	// the connection.
+	PingTimeout time.Duration
+	// StrictMaxConcurrentStreams makes the client honor the server's
+	// SETTINGS_MAX_CONCURRENT_STREAMS globally instead of per connection.
+	StrictMaxConcurrentStreams bool
+}
+
+// SetHTTP2Settings applies HTTP/2 tuning to the underlying transport. On the
+// standard *http.Transport it forces HTTP/2 and applies the header size cap;
+// the remaining knobs take effect when the client was built with an
+// http2.Transport (golang.org/x/net/http2), whose matching fields are set
+// without this package depending on it.
+func (c *Client) SetHTTP2Settings(settings HTTP2Settings) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.http2Settings = &settings
+
+	switch transport := c.HTTPClient.Transport.(type) {
+	case nil:
+		base := &http.Transport{TLSClientConfig: c.TLSConfig}
+		applyHTTP2ToStdTransport(base, &settings)
+		c.HTTPClient.Transport = base
+	case *http.Transport:
+		applyHTTP2ToStdTransport(transport, &settings)
+	default:
+		applyHTTP2ByFieldName(transport, &settings)
+	}
+	return c
+}
+
+// getHTTP2Settings returns the configured HTTP/2 settings under the read lock.
+func (c *Client) getHTTP2Settings() *HTTP2Settings {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.http2Settings
+}
+
+// applyHTTP2ToStdTransport applies the settings the standard transport
+// understands directly.
+func applyHTTP2ToStdTransport(transport *http.Transport, settings *HTTP2Settings) {
+	transport.ForceAttemptHTTP2 = true
+	if settings.MaxHeaderListSize > 0 {
+		transport.MaxResponseHeaderBytes = int64(settings.MaxHeaderListSize)
+	}
+}
+
+// applyHTTP2ByFieldName copies settings onto a transport exposing the
+// http2.Transport field set, matched by name and type via reflection so the
+// package works with x/net/http2 without importing it.
+func applyHTTP2ByFieldName(transport http.RoundTripper, settings *HTTP2Settings) {
+	value := reflect.ValueOf(transport)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+	value = value.Elem()
+
+	setField := func(name string, fieldValue interface{}) {
+		field := value.FieldByName(name)
+		want := reflect.ValueOf(fieldValue)
+		if field.IsValid() && field.CanSet() && field.Type() == want.Type() {
+			field.Set(want)
+		}
+	}
+
+	if settings.MaxHeaderListSize > 0 {
+		setField("MaxHeaderListSize", settings.MaxHeaderListSize)
+	}
+	if settings.MaxReadFrameSize > 0 {
+		setField("MaxReadFrameSize", settings.MaxReadFrameSize)
+	}
+	if settings.ReadIdleTimeout > 0 {
+		setField("ReadIdleTimeout", settings.ReadIdleTimeout)
+	}
+	if settings.PingTimeout > 0 {
+		setField("PingTimeout", settings.PingTimeout)
+	}
+	if settings.StrictMaxConcurrentStreams {
+		setField("StrictMaxConcurrentStreams", true)
+	}
+}
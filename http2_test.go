@@ -0,0 +1,65 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHTTP2Transport mirrors the tuning fields of x/net/http2's Transport so
+// the reflection-based application can be exercised without the dependency.
+type fakeHTTP2Transport struct {
+	MaxHeaderListSize          uint32
+	MaxReadFrameSize           uint32
+	ReadIdleTimeout            time.Duration
+	PingTimeout                time.Duration
+	StrictMaxConcurrentStreams bool
+}
+
+func (t *fakeHTTP2Transport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, http.ErrSkipAltProtocol
+}
+
+func TestSetHTTP2SettingsStdTransport(t *testing.T) {
+	client := Create(nil)
+	client.SetHTTP2Settings(HTTP2Settings{MaxHeaderListSize: 1 << 16})
+
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.Equal(t, int64(1<<16), transport.MaxResponseHeaderBytes)
+
+	settings := client.getHTTP2Settings()
+	require.NotNil(t, settings)
+	assert.Equal(t, uint32(1<<16), settings.MaxHeaderListSize)
+}
+
+func TestSetHTTP2SettingsFieldMatching(t *testing.T) {
+	transport := &fakeHTTP2Transport{}
+	client := Create(&Config{Transport: transport})
+	client.SetHTTP2Settings(HTTP2Settings{
+		MaxHeaderListSize:          4096,
+		MaxReadFrameSize:           1 << 20,
+		ReadIdleTimeout:            30 * time.Second,
+		PingTimeout:                5 * time.Second,
+		StrictMaxConcurrentStreams: true,
+	})
+
+	assert.Equal(t, uint32(4096), transport.MaxHeaderListSize)
+	assert.Equal(t, uint32(1<<20), transport.MaxReadFrameSize)
+	assert.Equal(t, 30*time.Second, transport.ReadIdleTimeout)
+	assert.Equal(t, 5*time.Second, transport.PingTimeout)
+	assert.True(t, transport.StrictMaxConcurrentStreams)
+}
+
+func TestSetHTTP2SettingsZeroValuesLeaveTransportAlone(t *testing.T) {
+	transport := &fakeHTTP2Transport{ReadIdleTimeout: time.Minute}
+	client := Create(&Config{Transport: transport})
+	client.SetHTTP2Settings(HTTP2Settings{})
+
+	assert.Equal(t, time.Minute, transport.ReadIdleTimeout)
+	assert.Zero(t, transport.MaxHeaderListSize)
+}
@@ -0,0 +1,94 @@
+package requests
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// headerIdempotencyKey is the header compliant servers (Stripe-style) use to
+// deduplicate retried unsafe requests.
+const headerIdempotencyKey = "Idempotency-Key"
+
+// IdempotencyKey sets the Idempotency-Key header, so servers that support it
+// can deduplicate this request when the client retries it. The key is set
+// once per logical request and therefore shared by every retry attempt.
+func (b *RequestBuilder) IdempotencyKey(key string) *RequestBuilder {
+	b.idempotencyKey = key
+	return b.Header(headerIdempotencyKey, key)
+}
+
+// AutoIdempotencyKey generates a fresh UUIDv7 idempotency key for this
+// request. Use SetAutoIdempotencyKey to do this for every unsafe request.
+func (b *RequestBuilder) AutoIdempotencyKey() *RequestBuilder {
+	return b.IdempotencyKey(newUUIDv7())
+}
+
+// SetAutoIdempotencyKey opts in to generating an Idempotency-Key for every
+// POST and PATCH request that does not carry one already. Keys are UUIDv7 —
+// time-ordered, so server-side dedup storage stays index-friendly — and are
+// generated once per logical request, surviving retries and re-auth replays.
+func (c *Client) SetAutoIdempotencyKey(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.autoIdempotencyKey = enabled
+	return c
+}
+
+// autoIdempotencyEnabled reports whether idempotency keys are auto-generated.
+func (c *Client) autoIdempotencyEnabled() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.autoIdempotencyKey
+}
+
+// applyIdempotencyKey generates and pins the key for unsafe methods when auto
+// generation is on and the request does not carry one. The key is kept on the
+// builder so a re-sent logical request reuses it.
+func (b *RequestBuilder) applyIdempotencyKey(header http.Header) {
+	if !b.client.autoIdempotencyEnabled() {
+		return
+	}
+	if b.method != http.MethodPost && b.method != http.MethodPatch {
+		return
+	}
+	if header.Get(headerIdempotencyKey) != "" {
+		return
+	}
+	if b.idempotencyKey == "" {
+		b.idempotencyKey = newUUIDv7()
+	}
+	header.Set(headerIdempotencyKey, b.idempotencyKey)
+}
+
+// newUUIDv7 returns a RFC 9562 UUIDv7: a 48-bit millisecond timestamp
+// followed by random bits, with the version and variant fields set.
+func newUUIDv7() string {
+	var u [16]byte
+	_, _ = rand.Read(u[:])
+
+	ms := uint64(time.Now().UnixMilli()) //nolint:gosec // Non-negative for the next ~290k years.
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+	u[6] = (u[6] & 0x0f) | 0x70 // Version 7
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC variant
+
+	var text [36]byte
+	hex.Encode(text[0:8], u[0:4])
+	text[8] = '-'
+	hex.Encode(text[9:13], u[4:6])
+	text[13] = '-'
+	hex.Encode(text[14:18], u[6:8])
+	text[18] = '-'
+	hex.Encode(text[19:23], u[8:10])
+	text[23] = '-'
+	hex.Encode(text[24:36], u[10:16])
+	return string(text[:])
+}
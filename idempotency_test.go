@@ -0,0 +1,68 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var uuidV7Pattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestNewUUIDv7Format(t *testing.T) {
+	first := newUUIDv7()
+	second := newUUIDv7()
+
+	assert.Regexp(t, uuidV7Pattern, first)
+	assert.Regexp(t, uuidV7Pattern, second)
+	assert.NotEqual(t, first, second)
+}
+
+func TestIdempotencyKeyExplicit(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Post("/orders").
+		IdempotencyKey("order-123").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "order-123", req.Header.Get("Idempotency-Key"))
+}
+
+func TestAutoIdempotencyKeyReusedAcrossRetries(t *testing.T) {
+	var keys []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 2})
+	client.SetAutoIdempotencyKey(true)
+
+	resp, err := client.Post("/orders").JSONBody(map[string]int{"qty": 1}).Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	require.Len(t, keys, 3)
+	assert.Regexp(t, uuidV7Pattern, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+	assert.Equal(t, keys[0], keys[2])
+}
+
+func TestAutoIdempotencyKeySkipsSafeMethods(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+	client.SetAutoIdempotencyKey(true)
+
+	req, err := client.Get("/orders").Build(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, req.Header.Get("Idempotency-Key"))
+}
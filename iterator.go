@@ -0,0 +1,119 @@
+package requests
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// ByteSeq is a push-style iterator over byte slices. On Go 1.23+ it can be
+// consumed directly with `for chunk := range seq`; on earlier versions call
+// it with a yield function and return false to stop early. Yielded slices are
+// only valid until the next iteration step; copy them to retain.
+type ByteSeq func(yield func([]byte) bool)
+
+// EventSeq is a push-style iterator over server-sent events, with the same
+// consumption rules as ByteSeq.
+type EventSeq func(yield func(Event) bool)
+
+// Event is a single server-sent event as defined by the SSE specification.
+type Event struct {
+	ID   string // Last "id:" field, if any
+	Name string // "event:" field; empty means the default "message" type
+	Data string // Concatenated "data:" lines, joined with newlines
+}
+
+// Lines returns an iterator over the lines of the buffered response body,
+// without trailing line terminators. Iteration stops early if the response
+// context is canceled.
+func (r *Response) Lines() ByteSeq {
+	return func(yield func([]byte) bool) {
+		scanner := bufio.NewScanner(bytes.NewReader(r.BodyBytes))
+		scanner.Buffer(make([]byte, 0, maxStreamBufferSize), maxStreamBufferSize)
+		for scanner.Scan() {
+			if r.Context != nil && r.Context.Err() != nil {
+				return
+			}
+			if !yield(scanner.Bytes()) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over the buffered response body in chunks of at
+// most size bytes; the final chunk may be shorter. Iteration stops early if
+// the response context is canceled. A non-positive size yields nothing.
+func (r *Response) Chunks(size int) ByteSeq {
+	return func(yield func([]byte) bool) {
+		if size <= 0 {
+			return
+		}
+		for body := r.BodyBytes; len(body) > 0; {
+			if r.Context != nil && r.Context.Err() != nil {
+				return
+			}
+			n := size
+			if n > len(body) {
+				n = len(body)
+			}
+			if !yield(body[:n]) {
+				return
+			}
+			body = body[n:]
+		}
+	}
+}
+
+// Events returns an iterator over the server-sent events in the buffered
+// response body. Comment lines and unknown fields are ignored, and events
+// with no data are not yielded, per the SSE specification. Iteration stops
+// early if the response context is canceled.
+func (r *Response) Events() EventSeq {
+	return func(yield func(Event) bool) {
+		scanner := bufio.NewScanner(bytes.NewReader(r.BodyBytes))
+		scanner.Buffer(make([]byte, 0, maxStreamBufferSize), maxStreamBufferSize)
+
+		var event Event
+		var data []string
+		flush := func() bool {
+			if len(data) == 0 {
+				event = Event{ID: event.ID}
+				return true
+			}
+			event.Data = strings.Join(data, "\n")
+			ok := yield(event)
+			event = Event{ID: event.ID}
+			data = nil
+			return ok
+		}
+
+		for scanner.Scan() {
+			if r.Context != nil && r.Context.Err() != nil {
+				return
+			}
+			line := scanner.Text()
+			if line == "" {
+				if !flush() {
+					return
+				}
+				continue
+			}
+			field, value := line, ""
+			if i := strings.IndexByte(line, ':'); i >= 0 {
+				field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+			}
+			switch field {
+			case "":
+				// Comment line; ignore.
+			case "id":
+				event.ID = value
+			case "event":
+				event.Name = value
+			case "data":
+				data = append(data, value)
+			}
+		}
+		flush()
+	}
+}
@@ -0,0 +1,105 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinesIterator(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("one\ntwo\nthree\n"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var lines []string
+	resp.Lines()(func(line []byte) bool {
+		lines = append(lines, string(line))
+		return true
+	})
+	assert.Equal(t, []string{"one", "two", "three"}, lines)
+
+	// Early termination stops the iteration.
+	var first []string
+	resp.Lines()(func(line []byte) bool {
+		first = append(first, string(line))
+		return false
+	})
+	assert.Equal(t, []string{"one"}, first)
+}
+
+func TestChunksIterator(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("abcdefgh"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var chunks []string
+	resp.Chunks(3)(func(chunk []byte) bool {
+		chunks = append(chunks, string(chunk))
+		return true
+	})
+	assert.Equal(t, []string{"abc", "def", "gh"}, chunks)
+
+	resp.Chunks(0)(func(chunk []byte) bool {
+		t.Error("Expected no chunks for non-positive size")
+		return true
+	})
+}
+
+func TestEventsIterator(t *testing.T) {
+	body := "event: greeting\nid: 1\ndata: hello\ndata: world\n\n: comment\ndata: plain\n\n"
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var events []Event
+	resp.Events()(func(event Event) bool {
+		events = append(events, event)
+		return true
+	})
+	require.Len(t, events, 2)
+	assert.Equal(t, Event{ID: "1", Name: "greeting", Data: "hello\nworld"}, events[0])
+	// The event ID carries over; the name resets to the default.
+	assert.Equal(t, Event{ID: "1", Data: "plain"}, events[1])
+}
+
+func TestIteratorContextCancellation(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("one\ntwo\n"))
+	}))
+	defer mockServer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(ctx)
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	cancel()
+	resp.Lines()(func(line []byte) bool {
+		t.Error("Expected no lines after context cancellation")
+		return true
+	})
+}
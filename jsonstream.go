@@ -0,0 +1,83 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Unbuffered leaves the response body on the wire instead of reading it
+// eagerly, so very large payloads can be decoded as they stream in with
+// ScanJSONStream or JSONArrayItems. Buffered accessors (Body, String, Scan)
+// see an empty body, and the caller owns closing the response.
+func (b *RequestBuilder) Unbuffered() *RequestBuilder {
+	b.unbuffered = true
+	return b
+}
+
+// bodyReader returns the buffered body when one exists, or the live response
+// stream for unbuffered responses.
+func (r *Response) bodyReader() io.Reader {
+	if r.BodyBytes != nil {
+		return bytes.NewReader(r.BodyBytes)
+	}
+	r.peekMu.Lock()
+	defer r.peekMu.Unlock()
+	if r.peeker != nil {
+		// Continue after a Peek so read-ahead bytes are not lost.
+		return r.peeker
+	}
+	return r.RawResponse.Body
+}
+
+// ScanJSONStream decodes the body into v with a streaming decoder, reading
+// directly from the response stream when the body was not pre-buffered (see
+// Unbuffered). Strict decoding applies as in ScanJSON.
+func (r *Response) ScanJSONStream(v interface{}) error {
+	if r.isStrictDecode() {
+		decoder := json.NewDecoder(r.bodyReader())
+		decoder.DisallowUnknownFields()
+		return decoder.Decode(v)
+	}
+	return r.Client.JSONDecoder.Decode(r.bodyReader(), v)
+}
+
+// JSONArrayItems returns an iterator over the elements of a JSON array body,
+// decoding one element at a time so huge arrays never reside in memory as a
+// whole. A decode failure is yielded once as the error and ends iteration;
+// iteration also stops early if the response context is canceled. The caller
+// still owns closing the response.
+func JSONArrayItems[T any](r *Response) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		var zero T
+		decoder := json.NewDecoder(r.bodyReader())
+
+		token, err := decoder.Token()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			yield(zero, fmt.Errorf("%w: expected JSON array, got %v", ErrUnsupportedDataType, token))
+			return
+		}
+
+		for decoder.More() {
+			if r.Context != nil && r.Context.Err() != nil {
+				return
+			}
+			var item T
+			if err := decoder.Decode(&item); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+		// Consume the closing bracket so the stream is left positioned
+		// after the array.
+		_, _ = decoder.Token()
+	}
+}
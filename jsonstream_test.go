@@ -0,0 +1,102 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanJSONStreamUnbuffered(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"stream","count":7}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Unbuffered().Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	// The body was not pre-buffered.
+	assert.Nil(t, resp.BodyBytes)
+
+	var v struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	require.NoError(t, resp.ScanJSONStream(&v))
+	assert.Equal(t, "stream", v.Name)
+	assert.Equal(t, 7, v.Count)
+}
+
+func TestJSONArrayItems(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":1},{"id":2},{"id":3}]`)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Unbuffered().Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	type item struct {
+		ID int `json:"id"`
+	}
+
+	var ids []int
+	JSONArrayItems[item](resp)(func(v item, err error) bool {
+		require.NoError(t, err)
+		ids = append(ids, v.ID)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestJSONArrayItemsOnBufferedBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[1,2]`)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var got []int
+	JSONArrayItems[int](resp)(func(v int, err error) bool {
+		require.NoError(t, err)
+		got = append(got, v)
+		return true
+	})
+	assert.Equal(t, []int{1, 2}, got)
+}
+
+func TestJSONArrayItemsRejectsNonArray(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"not":"array"}`)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var yielded error
+	JSONArrayItems[int](resp)(func(v int, err error) bool {
+		yielded = err
+		return true
+	})
+	assert.ErrorIs(t, yielded, ErrUnsupportedDataType)
+}
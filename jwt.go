@@ -0,0 +1,97 @@
+package requests
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultJWTLeeway is how long before expiry a token is refreshed when no
+// Leeway is configured, absorbing clock skew between client and issuer.
+const defaultJWTLeeway = 30 * time.Second
+
+// JWTAuth applies a Bearer JWT and keeps it fresh: the token's expiry is
+// parsed from its claims, and RefreshFunc is invoked Leeway before expiry to
+// obtain a replacement. Refreshes are single-flight — concurrent requests
+// wait for one refresh rather than each triggering their own. Use a pointer
+// so state is shared: client.SetAuth(&JWTAuth{...}).
+type JWTAuth struct {
+	Token       string                 // Current JWT
+	RefreshFunc func() (string, error) // Returns a replacement token; nil disables refresh
+	Leeway      time.Duration          // Refresh this long before expiry; defaults to 30s
+
+	mu          sync.Mutex
+	parsedToken string
+	expiry      time.Time
+}
+
+// Apply adds the Bearer token to the request, refreshing it first when it is
+// missing or about to expire. A failed refresh falls back to the current
+// token so the request still carries credentials.
+func (j *JWTAuth) Apply(req *http.Request) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.needsRefreshLocked() && j.RefreshFunc != nil {
+		if token, err := j.RefreshFunc(); err == nil && token != "" {
+			j.Token = token
+		}
+	}
+	if j.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+j.Token)
+	}
+}
+
+// Valid checks if a token is present.
+func (j *JWTAuth) Valid() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.Token != ""
+}
+
+// needsRefreshLocked reports whether the token is absent or inside the
+// leeway window before its parsed expiry. The caller holds the lock.
+func (j *JWTAuth) needsRefreshLocked() bool {
+	if j.Token == "" {
+		return true
+	}
+
+	if j.parsedToken != j.Token {
+		j.parsedToken = j.Token
+		j.expiry = parseJWTExpiry(j.Token)
+	}
+	if j.expiry.IsZero() {
+		// Tokens without a parseable exp claim are never proactively refreshed.
+		return false
+	}
+
+	leeway := j.Leeway
+	if leeway <= 0 {
+		leeway = defaultJWTLeeway
+	}
+	return time.Now().After(j.expiry.Add(-leeway))
+}
+
+// parseJWTExpiry extracts the exp claim from a JWT without verifying the
+// signature, returning the zero time when the claim cannot be read.
+func parseJWTExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(claims.Exp), 0)
+}
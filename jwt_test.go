@@ -0,0 +1,116 @@
+package requests
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeTestJWT builds an unsigned JWT whose payload carries the given expiry.
+func makeTestJWT(exp time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp.Unix())))
+	return header + "." + payload + ".sig"
+}
+
+func TestParseJWTExpiry(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	parsed := parseJWTExpiry(makeTestJWT(exp))
+	assert.True(t, parsed.Equal(exp))
+
+	assert.True(t, parseJWTExpiry("not-a-jwt").IsZero())
+	assert.True(t, parseJWTExpiry("a.!!!.c").IsZero())
+}
+
+func TestJWTAuthAppliesBearerToken(t *testing.T) {
+	auth := &JWTAuth{Token: makeTestJWT(time.Now().Add(time.Hour))}
+	require.True(t, auth.Valid())
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Equal(t, "Bearer "+auth.Token, req.Header.Get("Authorization"))
+}
+
+func TestJWTAuthRefreshesWithinLeeway(t *testing.T) {
+	fresh := makeTestJWT(time.Now().Add(time.Hour))
+	auth := &JWTAuth{
+		Token:  makeTestJWT(time.Now().Add(10 * time.Second)),
+		Leeway: time.Minute,
+		RefreshFunc: func() (string, error) {
+			return fresh, nil
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Equal(t, "Bearer "+fresh, req.Header.Get("Authorization"))
+}
+
+func TestJWTAuthSkipsRefreshWhenFresh(t *testing.T) {
+	token := makeTestJWT(time.Now().Add(time.Hour))
+	auth := &JWTAuth{
+		Token: token,
+		RefreshFunc: func() (string, error) {
+			t.Fatal("refresh should not run for a fresh token")
+			return "", nil
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Equal(t, "Bearer "+token, req.Header.Get("Authorization"))
+}
+
+func TestJWTAuthRefreshSingleFlight(t *testing.T) {
+	var refreshes int32
+	fresh := makeTestJWT(time.Now().Add(time.Hour))
+	auth := &JWTAuth{
+		Token:  makeTestJWT(time.Now().Add(-time.Minute)),
+		Leeway: time.Second,
+		RefreshFunc: func() (string, error) {
+			atomic.AddInt32(&refreshes, 1)
+			time.Sleep(10 * time.Millisecond)
+			return fresh, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+			require.NoError(t, err)
+			auth.Apply(req)
+			assert.Equal(t, "Bearer "+fresh, req.Header.Get("Authorization"))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshes))
+}
+
+func TestJWTAuthKeepsTokenOnRefreshFailure(t *testing.T) {
+	stale := makeTestJWT(time.Now().Add(-time.Minute))
+	auth := &JWTAuth{
+		Token: stale,
+		RefreshFunc: func() (string, error) {
+			return "", assert.AnError
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	require.NoError(t, err)
+	auth.Apply(req)
+	assert.Equal(t, "Bearer "+stale, req.Header.Get("Authorization"))
+}
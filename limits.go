@@ -0,0 +1,53 @@
+package requests
+
+import (
+	"net/http"
+)
+
+// Limits caps the resources a response may consume, so a hostile or broken
+// server cannot force unbounded memory use. Zero values leave the respective
+// limit off.
+type Limits struct {
+	// MaxResponseHeaderBytes caps the response header block; it is enforced
+	// by the transport, which fails the request when exceeded.
+	MaxResponseHeaderBytes int64
+	// MaxBodyBytes caps how many body bytes are buffered into a Response;
+	// larger bodies fail with ErrBodyTooLarge instead of being read to the
+	// end. Streaming and unbuffered reads are not limited.
+	MaxBodyBytes int64
+	// MaxDrainBytes caps how many bytes are read to drain a body before an
+	// early close (retries, redirect chains) so the connection can be
+	// reused. Past the cap the connection is dropped instead of drained.
+	MaxDrainBytes int64
+}
+
+// SetLimits applies response size limits to the client. The header limit is
+// installed on the underlying transport; the body and drain limits are
+// enforced as responses are read.
+func (c *Client) SetLimits(limits Limits) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.limits = limits
+
+	if limits.MaxResponseHeaderBytes > 0 {
+		switch transport := c.HTTPClient.Transport.(type) {
+		case nil:
+			c.HTTPClient.Transport = &http.Transport{
+				TLSClientConfig:        c.TLSConfig,
+				MaxResponseHeaderBytes: limits.MaxResponseHeaderBytes,
+			}
+		case *http.Transport:
+			transport.MaxResponseHeaderBytes = limits.MaxResponseHeaderBytes
+		}
+	}
+	return c
+}
+
+// getLimits returns the configured limits under the read lock.
+func (c *Client) getLimits() Limits {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.limits
+}
@@ -0,0 +1,51 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBodyBytesRejectsOversizedBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", 2048)))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetLimits(Limits{MaxBodyBytes: 1024})
+
+	_, err := client.Get("/").Send(context.Background())
+	require.ErrorIs(t, err, ErrBodyTooLarge)
+}
+
+func TestMaxBodyBytesAllowsBodyAtLimit(t *testing.T) {
+	payload := strings.Repeat("x", 1024)
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(payload))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetLimits(Limits{MaxBodyBytes: 1024})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, payload, resp.String())
+}
+
+func TestMaxResponseHeaderBytesAppliedToTransport(t *testing.T) {
+	client := Create(nil)
+	client.SetLimits(Limits{MaxResponseHeaderBytes: 4096})
+
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, int64(4096), transport.MaxResponseHeaderBytes)
+}
@@ -0,0 +1,113 @@
+package requests
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Link is one web link from an RFC 8288 Link response header.
+type Link struct {
+	// URL is the link target, resolved against the request URL when relative.
+	URL string
+	// Rel is the link relation, e.g. "next" or "prev".
+	Rel string
+	// Params holds the remaining link parameters (title, type, ...), with
+	// rel excluded. Keys are lowercased.
+	Params map[string]string
+}
+
+// Links parses the response's Link headers (RFC 8288) into links keyed by
+// relation. Relative targets are resolved against the request URL. When a
+// relation appears more than once, the first occurrence wins.
+func (r *Response) Links() map[string]Link {
+	links := make(map[string]Link)
+	for _, value := range r.Header().Values("Link") {
+		for _, link := range parseLinkHeader(value) {
+			link.URL = r.resolveLinkURL(link.URL)
+			if _, seen := links[link.Rel]; !seen {
+				links[link.Rel] = link
+			}
+		}
+	}
+	return links
+}
+
+// NextPageURL returns the target of the rel="next" link, or "" when the
+// response declares none — the manual-pagination counterpart to the iterator.
+func (r *Response) NextPageURL() string {
+	return r.Links()["next"].URL
+}
+
+// resolveLinkURL resolves a link target against the request URL.
+func (r *Response) resolveLinkURL(target string) string {
+	ref, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+	if ref.IsAbs() || r.RawResponse.Request == nil || r.RawResponse.Request.URL == nil {
+		return target
+	}
+	return r.RawResponse.Request.URL.ResolveReference(ref).String()
+}
+
+// parseLinkHeader parses one Link header value: comma-separated links, each a
+// <target> followed by semicolon-separated parameters.
+func parseLinkHeader(value string) []Link {
+	var links []Link
+	for _, entry := range splitLinkEntries(value) {
+		entry = strings.TrimSpace(entry)
+		if !strings.HasPrefix(entry, "<") {
+			continue
+		}
+		end := strings.Index(entry, ">")
+		if end < 0 {
+			continue
+		}
+
+		link := Link{URL: entry[1:end], Params: make(map[string]string)}
+		for _, param := range strings.Split(entry[end+1:], ";") {
+			key, paramValue, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found {
+				continue
+			}
+			key = strings.ToLower(key)
+			paramValue = strings.Trim(paramValue, `"`)
+			if key == "rel" {
+				link.Rel = paramValue
+			} else {
+				link.Params[key] = paramValue
+			}
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+// splitLinkEntries splits a Link header on commas outside of <...> targets
+// and quoted parameter values.
+func splitLinkEntries(value string) []string {
+	var entries []string
+	var inTarget, inQuotes bool
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '<':
+			if !inQuotes {
+				inTarget = true
+			}
+		case '>':
+			if !inQuotes {
+				inTarget = false
+			}
+		case '"':
+			inQuotes = !inQuotes
+		case ',':
+			if !inTarget && !inQuotes {
+				entries = append(entries, value[start:i])
+				start = i + 1
+			}
+		}
+	}
+	entries = append(entries, value[start:])
+	return entries
+}
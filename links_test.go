@@ -0,0 +1,58 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLinkHeader(t *testing.T) {
+	links := parseLinkHeader(`<https://api.example.com/items?page=2>; rel="next"; title="Next, please", </items?page=1>; rel="prev"`)
+
+	require.Len(t, links, 2)
+	assert.Equal(t, "https://api.example.com/items?page=2", links[0].URL)
+	assert.Equal(t, "next", links[0].Rel)
+	assert.Equal(t, "Next, please", links[0].Params["title"])
+	assert.Equal(t, "/items?page=1", links[1].URL)
+	assert.Equal(t, "prev", links[1].Rel)
+}
+
+func TestResponseLinksAndNextPage(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Link", `</items?page=2>; rel="next"`)
+		w.Header().Add("Link", `</items?page=9>; rel="last"`)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	resp, err := client.Get("/items").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	links := resp.Links()
+	require.Contains(t, links, "next")
+	require.Contains(t, links, "last")
+	assert.Equal(t, mockServer.URL+"/items?page=2", links["next"].URL)
+	assert.Equal(t, mockServer.URL+"/items?page=2", resp.NextPageURL())
+}
+
+func TestNextPageURLEmptyWithoutLink(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	resp, err := client.Get("/items").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Empty(t, resp.NextPageURL())
+}
@@ -1,6 +1,10 @@
 package requests
 
-import "net/http"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
 // MiddlewareHandlerFunc defines a function that takes an http.Request and returns an http.Response and an error.
 type MiddlewareHandlerFunc func(req *http.Request) (*http.Response, error)
@@ -8,3 +12,60 @@ type MiddlewareHandlerFunc func(req *http.Request) (*http.Response, error)
 // Middleware defines a function that takes an http.Request and returns an http.Response and an error.
 // It wraps around a next function call, which can be another middleware or the final transport layer call.
 type Middleware func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc
+
+// MiddlewareError attributes a middleware chain failure to the middleware
+// that produced it, so observability layers can tell cache from auth from
+// transport failures without string matching.
+type MiddlewareError struct {
+	Name  string // Name of the middleware; empty for anonymous ones
+	Index int    // Position in the executed chain, outermost first
+	Err   error  // The underlying error
+}
+
+// Error implements the error interface.
+func (e *MiddlewareError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("middleware %q (index %d): %v", e.Name, e.Index, e.Err)
+	}
+	return fmt.Sprintf("middleware at index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *MiddlewareError) Unwrap() error {
+	return e.Err
+}
+
+// transportError marks errors originating below the middleware chain so they
+// are not attributed to a middleware. It is unwrapped before leaving do().
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+// attributeMiddlewareError wraps a middleware handler so errors first
+// surfacing at this layer are tagged with the middleware's name and index.
+func attributeMiddlewareError(name string, index int, handler MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		resp, err := handler(req)
+		if err != nil {
+			var tagged *MiddlewareError
+			var transport *transportError
+			if !errors.As(err, &tagged) && !errors.As(err, &transport) {
+				err = &MiddlewareError{Name: name, Index: index, Err: err}
+			}
+		}
+		return resp, err
+	}
+}
+
+// ResponseInterceptor runs after the wrapped *Response has been built, with
+// the body buffered and retry metadata attached. It may mutate the response
+// in place, return a replacement, or return an error to fail the call.
+type ResponseInterceptor func(resp *Response) (*Response, error)
@@ -3,6 +3,7 @@ package requests
 import (
 	"bytes"
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -207,3 +208,251 @@ func TestRequestMiddlewareAddition(t *testing.T) {
 		t.Errorf("Middleware executed in incorrect order. Expected %s, got %s", expectedOrder, executionOrder.String())
 	}
 }
+
+// TestNamedMiddleware covers adding, replacing, inserting, and removing
+// middlewares by name.
+func TestNamedMiddleware(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	var order []string
+	tag := func(label string) Middleware {
+		return func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, label)
+				return next(req)
+			}
+		}
+	}
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.UseNamed("auth", tag("auth"))
+	client.UseNamed("logging", tag("logging"))
+
+	names := client.MiddlewareNames()
+	if len(names) != 2 || names[0] != "auth" || names[1] != "logging" {
+		t.Fatalf("Expected names [auth logging], got %v", names)
+	}
+
+	// Replacing keeps the original position.
+	client.UseNamed("auth", tag("auth2"))
+	if names = client.MiddlewareNames(); len(names) != 2 || names[0] != "auth" {
+		t.Fatalf("Expected auth to stay first, got %v", names)
+	}
+
+	// Inserting before a named middleware shifts it down the chain.
+	if !client.InsertBefore("logging", tag("metrics")) {
+		t.Fatal("Expected InsertBefore to find 'logging'")
+	}
+	if client.InsertBefore("missing", tag("unused")) {
+		t.Fatal("Expected InsertBefore to miss 'missing'")
+	}
+
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	expected := []string{"auth2", "metrics", "logging"}
+	if len(order) != len(expected) {
+		t.Fatalf("Expected order %v, got %v", expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("Expected order %v, got %v", expected, order)
+		}
+	}
+
+	// Removal drops the middleware from the chain.
+	if !client.RemoveMiddleware("auth") {
+		t.Fatal("Expected RemoveMiddleware to find 'auth'")
+	}
+	if client.RemoveMiddleware("auth") {
+		t.Fatal("Expected second removal to report missing")
+	}
+	if names = client.MiddlewareNames(); len(names) != 2 {
+		t.Fatalf("Expected two middlewares after removal, got %v", names)
+	}
+}
+
+// TestResponseInterceptor verifies the interceptor chain runs on the wrapped
+// response with the body buffered, and can transform or fail the call.
+func TestResponseInterceptor(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":"payload"}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.UseResponseInterceptor(func(resp *Response) (*Response, error) {
+		// BodyBytes is available and can be rewritten in place.
+		resp.BodyBytes = bytes.ToUpper(resp.BodyBytes)
+		return resp, nil
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	if resp.String() != `{"DATA":"PAYLOAD"}` {
+		t.Errorf("Expected interceptor to rewrite body, got %q", resp.String())
+	}
+	if resp.Attempts() != 1 {
+		t.Errorf("Expected interceptor-visible attempt count 1, got %d", resp.Attempts())
+	}
+
+	// An interceptor error fails the call.
+	client.UseResponseInterceptor(func(resp *Response) (*Response, error) {
+		return nil, context.Canceled
+	})
+	if _, err = client.Get("/").Send(context.Background()); err == nil {
+		t.Error("Expected interceptor error to surface from Send")
+	}
+}
+
+func TestMiddlewareErrorAttribution(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.UseNamed("passthrough", func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+		return next
+	})
+	client.UseNamed("auth", func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("token expired")
+		}
+	})
+
+	_, err := client.Get("/").Send(context.Background())
+	var mwErr *MiddlewareError
+	if !errors.As(err, &mwErr) {
+		t.Fatalf("Expected a *MiddlewareError, got %v", err)
+	}
+	if mwErr.Name != "auth" {
+		t.Errorf("Expected error attributed to %q, got %q", "auth", mwErr.Name)
+	}
+	if mwErr.Index != 1 {
+		t.Errorf("Expected chain index 1, got %d", mwErr.Index)
+	}
+	if mwErr.Unwrap() == nil || mwErr.Unwrap().Error() != "token expired" {
+		t.Errorf("Expected underlying error to be preserved, got %v", mwErr.Unwrap())
+	}
+}
+
+func TestMiddlewareErrorSkipsTransportFailures(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://127.0.0.1:0"})
+	client.UseNamed("passthrough", func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+		return next
+	})
+
+	_, err := client.Get("/").Send(context.Background())
+	if err == nil {
+		t.Fatal("Expected a transport error")
+	}
+	var mwErr *MiddlewareError
+	if errors.As(err, &mwErr) {
+		t.Errorf("Expected transport error to stay unattributed, got %v", err)
+	}
+}
+
+func TestSkipMiddlewares(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				return next(req)
+			}
+		}
+	}
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.UseNamed("cache", record("cache"))
+	client.UseNamed("auth", record("auth"))
+
+	resp, err := client.Get("/").SkipMiddlewares("cache").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	_ = resp.Close()
+
+	if len(calls) != 1 || calls[0] != "auth" {
+		t.Errorf("Expected only the auth middleware to run, got %v", calls)
+	}
+
+	// Without the skip, both middlewares run.
+	calls = nil
+	resp, err = client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	_ = resp.Close()
+
+	if len(calls) != 2 {
+		t.Errorf("Expected both middlewares to run, got %v", calls)
+	}
+}
+
+// TestPerAttemptMiddleware ensures per-attempt middleware re-runs on every
+// retry attempt and sees the attempt number on the context, unlike regular
+// middleware which wraps the whole retry loop.
+func TestPerAttemptMiddleware(t *testing.T) {
+	var hits int
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	var outerRuns int
+	var attempts []int
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 3})
+	client.SetRetryStrategy(DefaultBackoffStrategy(0))
+	client.AddMiddleware(func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			outerRuns++
+			return next(req)
+		}
+	})
+	client.AddPerAttemptMiddleware(func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			attempts = append(attempts, AttemptFromContext(req.Context()))
+			return next(req)
+		}
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Close() //nolint:errcheck
+
+	if outerRuns != 1 {
+		t.Errorf("Expected regular middleware to run once, ran %d times", outerRuns)
+	}
+	if len(attempts) != 3 {
+		t.Fatalf("Expected per-attempt middleware to run 3 times, ran %d times", len(attempts))
+	}
+	for i, attempt := range attempts {
+		if attempt != i+1 {
+			t.Errorf("Expected attempt %d at position %d, got %d", i+1, i, attempt)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Package middlewares provides reusable middleware implementations for the
+// requests client.
+package middlewares
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kaptinlin/requests"
+)
+
+// Retry exposes the retry loop as an ordinary middleware so it can be ordered
+// relative to signing or auth middleware — everything between this middleware
+// and the transport runs once per attempt — or replaced with a custom policy.
+// Each attempt carries its 1-based number on the request context, readable via
+// requests.AttemptFromContext. When this middleware is installed, leave the
+// client's MaxRetries at zero so requests are not retried twice.
+func Retry(config requests.RetryConfig) requests.Middleware {
+	return func(next requests.MiddlewareHandlerFunc) requests.MiddlewareHandlerFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			strategy := config.Strategy
+			if strategy == nil {
+				strategy = requests.DefaultBackoffStrategy(time.Second)
+			}
+			retryIf := config.RetryIf
+			if retryIf == nil {
+				retryIf = requests.DefaultRetryIf
+			}
+
+			var resp *http.Response
+			var err error
+			for attempt := 0; attempt <= config.MaxRetries; attempt++ {
+				attemptReq := req.WithContext(requests.WithAttempt(req.Context(), attempt+1))
+
+				// Rewind the body so retry attempts resend it from the start.
+				if attempt > 0 && req.GetBody != nil {
+					body, bodyErr := req.GetBody()
+					if bodyErr != nil {
+						return resp, bodyErr
+					}
+					attemptReq.Body = body
+				}
+
+				resp, err = next(attemptReq)
+
+				shouldRetry := err != nil || (resp != nil && retryIf(attemptReq, resp, err))
+				if !shouldRetry || attempt == config.MaxRetries {
+					break
+				}
+
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+
+				select {
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
+				case <-time.After(strategy(attempt)):
+				}
+			}
+			return resp, err
+		}
+	}
+}
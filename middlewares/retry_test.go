@@ -0,0 +1,84 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kaptinlin/requests"
+)
+
+// TestRetryMiddleware verifies the retry loop re-runs inner middleware per
+// attempt with the attempt number visible on the context.
+func TestRetryMiddleware(t *testing.T) {
+	var hits int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	var attempts []int
+	signing := func(next requests.MiddlewareHandlerFunc) requests.MiddlewareHandlerFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			// Inner middleware runs once per attempt and sees the attempt number.
+			attempts = append(attempts, requests.AttemptFromContext(req.Context()))
+			return next(req)
+		}
+	}
+
+	client := requests.Create(&requests.Config{BaseURL: mockServer.URL})
+	client.AddMiddleware(Retry(requests.RetryConfig{
+		MaxRetries: 3,
+		Strategy:   requests.DefaultBackoffStrategy(time.Millisecond),
+	}), signing)
+
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	if resp.StatusCode() != http.StatusOK {
+		t.Errorf("Expected status 200 after retries, got %d", resp.StatusCode())
+	}
+	if len(attempts) != 3 {
+		t.Fatalf("Expected 3 attempts through the signing middleware, got %v", attempts)
+	}
+	for i, attempt := range attempts {
+		if attempt != i+1 {
+			t.Fatalf("Expected attempt numbers [1 2 3], got %v", attempts)
+		}
+	}
+}
+
+// TestRetryMiddlewareGivesUp verifies the final failed response is returned
+// once the retry budget is exhausted.
+func TestRetryMiddlewareGivesUp(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer mockServer.Close()
+
+	client := requests.Create(&requests.Config{BaseURL: mockServer.URL})
+	client.AddMiddleware(Retry(requests.RetryConfig{
+		MaxRetries: 1,
+		Strategy:   requests.DefaultBackoffStrategy(time.Millisecond),
+	}))
+
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	if resp.StatusCode() != http.StatusInternalServerError {
+		t.Errorf("Expected final 500 response, got %d", resp.StatusCode())
+	}
+}
@@ -0,0 +1,226 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// Failed base URLs sit out a cooldown that doubles with each consecutive
+// failure, so a down region is probed again occasionally instead of being
+// hammered or abandoned.
+const (
+	failoverBaseCooldown = 30 * time.Second
+	failoverMaxCooldown  = 5 * time.Minute
+)
+
+// baseURLEntry tracks one base URL and its health.
+type baseURLEntry struct {
+	url           string
+	weight        int
+	currentWeight int // smooth weighted round-robin state
+	failures      int // consecutive failures
+	requests      int64
+	errors        int64
+	downUntil     time.Time
+}
+
+// baseURLPool holds the configured base URLs, either in failover preference
+// order or as a weighted balancing set.
+type baseURLPool struct {
+	mu       sync.Mutex
+	entries  []*baseURLEntry
+	balanced bool
+}
+
+// SetBaseURLs configures a primary base URL with fallback mirrors. Requests
+// go to the primary; on connection errors or 5xx responses the next healthy
+// mirror is tried in order. A failing URL is marked down for a growing
+// cooldown and recovers automatically once it answers again. The primary
+// also becomes the client's BaseURL, so Build and single-URL paths keep
+// working unchanged.
+func (c *Client) SetBaseURLs(primary string, fallbacks ...string) *Client {
+	entries := make([]*baseURLEntry, 0, len(fallbacks)+1)
+	for _, u := range append([]string{primary}, fallbacks...) {
+		entries = append(entries, &baseURLEntry{url: u, weight: 1})
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.BaseURL = primary
+	c.baseURLs = &baseURLPool{entries: entries}
+	return c
+}
+
+// getBaseURLPool returns the mirror pool under the read lock.
+func (c *Client) getBaseURLPool() *baseURLPool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.baseURLs
+}
+
+// candidates returns the healthy base URLs in preference order, or every URL
+// when all of them are cooling down, so a fully degraded pool still tries
+// rather than failing without a request.
+func (p *baseURLPool) candidates() []*baseURLEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]*baseURLEntry, 0, len(p.entries))
+	for _, entry := range p.entries {
+		if entry.downUntil.Before(now) {
+			healthy = append(healthy, entry)
+		}
+	}
+	if len(healthy) == 0 {
+		return append([]*baseURLEntry{}, p.entries...)
+	}
+	if p.balanced {
+		p.reorderWeightedLocked(healthy)
+	}
+	return healthy
+}
+
+// markSuccess records a healthy exchange, clearing any cooldown.
+func (p *baseURLPool) markSuccess(entry *baseURLEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry.requests++
+	entry.failures = 0
+	entry.downUntil = time.Time{}
+}
+
+// markFailure records a failed exchange and extends the entry's cooldown
+// exponentially with its consecutive failure count.
+func (p *baseURLPool) markFailure(entry *baseURLEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry.requests++
+	entry.errors++
+	entry.failures++
+	cooldown := failoverBaseCooldown << (entry.failures - 1)
+	if cooldown > failoverMaxCooldown || cooldown <= 0 {
+		cooldown = failoverMaxCooldown
+	}
+	entry.downUntil = time.Now().Add(cooldown)
+}
+
+// sendWithFailover runs the request against each candidate base URL until
+// one answers without a connection error or 5xx. The last response and error
+// are returned when every mirror fails.
+func (b *RequestBuilder) sendWithFailover(ctx context.Context, pool *baseURLPool) (*Response, error) {
+	rewind, err := b.failoverRewinder()
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	for i, entry := range pool.candidates() {
+		if i > 0 {
+			if rewindErr := rewind(); rewindErr != nil {
+				return nil, rewindErr
+			}
+		}
+		b.baseURLOverride = entry.url
+		resp, err = b.sendOnce(ctx)
+
+		failedOver := (resp != nil && resp.StatusCode() >= 500) || (err != nil && isConnectionError(err))
+		if !failedOver {
+			if err == nil {
+				pool.markSuccess(entry)
+			}
+			return resp, err
+		}
+
+		pool.markFailure(entry)
+		if err == nil {
+			// Release the 5xx response before trying the next mirror; its
+			// body is already buffered if the caller ends up receiving it.
+			_ = resp.Close()
+		}
+	}
+	return resp, err
+}
+
+// failoverRewinder makes the request body safe to replay against another
+// mirror. Buffered values and encodable payloads re-serialize on every send
+// and need nothing; a plain reader body is consumed by the first attempt, so
+// it is buffered up front. The returned function runs before each retry: it
+// re-opens file-backed bodies, which the transport closes after sending, and
+// reports ErrBodyNotRewindable for multipart file contents, whose readers
+// are consumed and closed during the first attempt — a clear error beats
+// silently re-sending a truncated body.
+func (b *RequestBuilder) failoverRewinder() (func() error, error) {
+	var rewinds []func() error
+
+	switch body := b.bodyData.(type) {
+	case nil, []byte, string:
+		// Replayable as-is.
+	case *os.File:
+		offset, seekErr := body.Seek(0, io.SeekCurrent)
+		name := body.Name()
+		rewinds = append(rewinds, func() error {
+			reopened, err := os.Open(name) //nolint:gosec // Re-opening the caller-provided file path.
+			if err != nil {
+				return err
+			}
+			if seekErr == nil && offset > 0 {
+				if _, err := reopened.Seek(offset, io.SeekStart); err != nil {
+					_ = reopened.Close()
+					return err
+				}
+			}
+			b.bodyData = reopened
+			return nil
+		})
+	case io.Reader:
+		buffered, readErr := io.ReadAll(body)
+		if readErr != nil {
+			return nil, fmt.Errorf("buffering request body failed: %w", readErr)
+		}
+		if closer, ok := body.(io.Closer); ok {
+			_ = closer.Close()
+		}
+		b.bodyData = buffered
+	}
+
+	if len(b.formFiles) > 0 {
+		rewinds = append(rewinds, func() error {
+			return fmt.Errorf("%w: multipart file contents were consumed by the previous attempt", ErrBodyNotRewindable)
+		})
+	}
+
+	return func() error {
+		for _, rewind := range rewinds {
+			if err := rewind(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// isConnectionError reports whether the error indicates the endpoint itself
+// is unreachable, as opposed to a caller-side cancellation or a
+// configuration problem that would fail on every mirror alike.
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, ErrDNS) || errors.Is(err, ErrConnect) ||
+		errors.Is(err, ErrTLSHandshake) || errors.Is(err, ErrResponseHeaderTimeout) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
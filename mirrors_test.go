@@ -0,0 +1,141 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverOn5xx(t *testing.T) {
+	var primaryHits atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryHits.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("from fallback"))
+	}))
+	defer fallback.Close()
+
+	client := Create(nil)
+	client.SetBaseURLs(primary.URL, fallback.URL)
+
+	resp, err := client.Get("/data").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, "from fallback", resp.String())
+	assert.Equal(t, int32(1), primaryHits.Load())
+}
+
+func TestFailoverOnConnectionError(t *testing.T) {
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fallback.Close()
+
+	client := Create(nil)
+	// Port 0 is never listening, so the primary fails to connect.
+	client.SetBaseURLs("http://127.0.0.1:0", fallback.URL)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+}
+
+func TestFailedPrimarySitsOutCooldown(t *testing.T) {
+	var primaryHits atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryHits.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fallback.Close()
+
+	client := Create(nil)
+	client.SetBaseURLs(primary.URL, fallback.URL)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+	}
+	// The primary should only have been hit once; later calls go straight to
+	// the healthy fallback while it cools down.
+	assert.Equal(t, int32(1), primaryHits.Load())
+}
+
+func TestFailoverReplaysReaderBody(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+
+	var fallbackBody []byte
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fallback.Close()
+
+	client := Create(nil)
+	client.SetBaseURLs(primary.URL, fallback.URL)
+
+	resp, err := client.Post("/ingest").
+		ContentType("application/x-ndjson").
+		Body(strings.NewReader(`{"seq":1}`)).
+		Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	// The reader was consumed against the primary; the mirror must still
+	// receive the complete body.
+	assert.Equal(t, `{"seq":1}`, string(fallbackBody))
+}
+
+func TestFailoverMultipartBodyNotRewindable(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fallback.Close()
+
+	client := Create(nil)
+	client.SetBaseURLs(primary.URL, fallback.URL)
+
+	_, err := client.Post("/upload").
+		File("file", "report.txt", io.NopCloser(strings.NewReader("contents"))).
+		Send(context.Background())
+	// The part's reader is consumed and closed by the first attempt, so the
+	// failover surfaces a clear error instead of re-sending an empty part.
+	require.ErrorIs(t, err, ErrBodyNotRewindable)
+}
+
+func TestAllMirrorsFailingReturnsLastResponse(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	client := Create(nil)
+	client.SetBaseURLs(down.URL, down.URL)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+}
@@ -0,0 +1,73 @@
+package requests
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// AcceptLanguage sets the Accept-Language header from the given language
+// tags in preference order, assigning descending q-values automatically.
+// Tags that already carry a ;q= parameter are kept as written.
+func (b *RequestBuilder) AcceptLanguage(tags ...string) *RequestBuilder {
+	if len(tags) == 0 {
+		return b
+	}
+	return b.Header("Accept-Language", joinWithQuality(tags))
+}
+
+// Negotiate sets the Accept header from the offered media types in preference
+// order and, after the response arrives, verifies its Content-Type matches
+// one of the offers — returning ErrNotAcceptable otherwise. This keeps
+// clients honest against backends that ignore Accept and answer in whatever
+// format they like.
+func (b *RequestBuilder) Negotiate(offers ...string) *RequestBuilder {
+	if len(offers) == 0 {
+		return b
+	}
+	b.negotiateOffers = offers
+	return b.Header("Accept", joinWithQuality(offers))
+}
+
+// joinWithQuality joins preference-ordered values into a header value with
+// descending q-values: the first value is sent bare (q=1), later ones step
+// down by 0.1 and floor at 0.1. Values already carrying ;q= are left alone.
+func joinWithQuality(values []string) string {
+	parts := make([]string, 0, len(values))
+	for i, value := range values {
+		if i == 0 || strings.Contains(value, ";q=") {
+			parts = append(parts, value)
+			continue
+		}
+		quality := 1.0 - 0.1*float64(i)
+		if quality < 0.1 {
+			quality = 0.1
+		}
+		parts = append(parts, fmt.Sprintf("%s;q=%.1f", value, quality))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// verifyNegotiation checks the response Content-Type against the offers
+// recorded by Negotiate, ignoring media type parameters like charset.
+func (b *RequestBuilder) verifyNegotiation(response *Response) error {
+	if len(b.negotiateOffers) == 0 {
+		return nil
+	}
+
+	contentType := response.ContentType()
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	for _, offer := range b.negotiateOffers {
+		offered, _, offerErr := mime.ParseMediaType(offer)
+		if offerErr != nil {
+			offered = strings.ToLower(strings.TrimSpace(offer))
+		}
+		if mediaType == offered {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: got %q, offered %s", ErrNotAcceptable, contentType, strings.Join(b.negotiateOffers, ", "))
+}
@@ -0,0 +1,67 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptLanguageQualityValues(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/").
+		AcceptLanguage("en-US", "fr", "de").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "en-US, fr;q=0.9, de;q=0.8", req.Header.Get("Accept-Language"))
+}
+
+func TestAcceptLanguageKeepsExplicitQuality(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/").
+		AcceptLanguage("en", "fr;q=0.3").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "en, fr;q=0.3", req.Header.Get("Accept-Language"))
+}
+
+func TestNegotiateAcceptsMatchingResponse(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json, application/xml;q=0.9", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	resp, err := client.Get("/").
+		Negotiate("application/json", "application/xml").
+		Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+}
+
+func TestNegotiateRejectsUnofferedContentType(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html></html>"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	resp, err := client.Get("/").
+		Negotiate("application/json", "application/xml").
+		Send(context.Background())
+	require.ErrorIs(t, err, ErrNotAcceptable)
+	require.NotNil(t, resp, "the response stays inspectable alongside the error")
+	defer resp.Close() //nolint:errcheck
+}
@@ -0,0 +1,118 @@
+package requests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cassette is the on-disk record of one HTTP exchange, stored as indented
+// JSON. Offline mode replays cassettes; tooling that records real traffic
+// (a VCR-style recorder) should write the same format so fixtures are
+// interchangeable. The body is base64-encoded by encoding/json, so binary
+// payloads round-trip safely.
+type Cassette struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+}
+
+// fixtureKey derives the stable hash that names a cassette file for the
+// given method and full request URL, query string included.
+func fixtureKey(method, rawURL string) string {
+	sum := sha256.Sum256([]byte(strings.ToUpper(method) + " " + rawURL))
+	return hex.EncodeToString(sum[:8])
+}
+
+// FixturePath returns the cassette file path offline mode reads for the
+// given method and URL, e.g. "fixtures/GET-1a2b3c4d5e6f7a8b.json".
+func FixturePath(dir, method, rawURL string) string {
+	name := strings.ToUpper(method) + "-" + fixtureKey(method, rawURL) + ".json"
+	return filepath.Join(dir, name)
+}
+
+// WriteCassette stores a cassette where offline mode will find it, creating
+// the directory as needed. Recorders and test setup use it so the file
+// naming scheme stays in one place.
+func WriteCassette(dir string, cassette *Cassette) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(FixturePath(dir, cassette.Method, cassette.URL), data, 0o644)
+}
+
+// SetOffline serves every request from cassette files in fixturesDir instead
+// of the network; requests with no matching cassette fail fast with
+// ErrFixtureNotFound. An empty fixturesDir restores the previous transport.
+// Demos and CI runs use this to replay recorded traffic without network
+// access.
+func (c *Client) SetOffline(fixturesDir string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, ok := c.HTTPClient.Transport.(*fixtureTransport)
+	switch {
+	case fixturesDir == "":
+		if ok {
+			c.HTTPClient.Transport = current.prev
+		}
+	case ok:
+		current.dir = fixturesDir
+	default:
+		c.HTTPClient.Transport = &fixtureTransport{dir: fixturesDir, prev: c.HTTPClient.Transport}
+	}
+	return c
+}
+
+// fixtureTransport replays cassettes from a directory. It keeps the replaced
+// transport so SetOffline("") can restore it.
+type fixtureTransport struct {
+	dir  string
+	prev http.RoundTripper
+}
+
+// RoundTrip loads the cassette matching the request and synthesizes its
+// response. No network activity ever happens here.
+func (t *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	data, err := os.ReadFile(FixturePath(t.dir, req.Method, req.URL.String()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s %s", ErrFixtureNotFound, req.Method, req.URL)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrFixtureNotFound, err) //nolint:errorlint
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("%w: %s %s: %v", ErrFixtureNotFound, req.Method, req.URL, err) //nolint:errorlint
+	}
+
+	header := cassette.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", cassette.Status, http.StatusText(cassette.Status)),
+		StatusCode:    cassette.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(cassette.Body)),
+		ContentLength: int64(len(cassette.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineReplaysCassette(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, WriteCassette(dir, &Cassette{
+		Method: "GET",
+		URL:    "http://api.example.com/users?page=2",
+		Status: http.StatusOK,
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   []byte(`{"users":["alice"]}`),
+	}))
+
+	client := Create(&Config{BaseURL: "http://api.example.com"})
+	client.SetOffline(dir)
+
+	resp, err := client.Get("/users").Query("page", "2").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"users":["alice"]}`, resp.String())
+}
+
+func TestOfflineUnmatchedRequestFailsFast(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://api.example.com"})
+	client.SetOffline(t.TempDir())
+
+	_, err := client.Get("/missing").Send(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrFixtureNotFound)
+}
+
+func TestOfflineKeyIncludesMethod(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, WriteCassette(dir, &Cassette{
+		Method: "GET",
+		URL:    "http://api.example.com/users",
+		Status: http.StatusOK,
+	}))
+
+	client := Create(&Config{BaseURL: "http://api.example.com"})
+	client.SetOffline(dir)
+
+	_, err := client.Delete("/users").Send(context.Background())
+	assert.ErrorIs(t, err, ErrFixtureNotFound)
+}
+
+func TestOfflineDisableRestoresTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetOffline(t.TempDir())
+
+	_, err := client.Get("/").Send(context.Background())
+	require.ErrorIs(t, err, ErrFixtureNotFound)
+
+	client.SetOffline("")
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode())
+}
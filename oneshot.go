@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetJSON sends a GET request and decodes the response into out, combining
+// build, send, status check, and Scan for the common case. A non-2xx status
+// yields an ErrUnexpectedStatus error alongside the response, which stays
+// readable for error details. Pass a nil out to skip decoding.
+func (c *Client) GetJSON(ctx context.Context, path string, out interface{}) (*Response, error) {
+	return sendAndScan(ctx, c.Get(path), out)
+}
+
+// PostJSON sends a POST request with in as the JSON body and decodes the
+// response into out. See GetJSON for status and decode semantics.
+func (c *Client) PostJSON(ctx context.Context, path string, in, out interface{}) (*Response, error) {
+	return sendAndScan(ctx, c.Post(path).JSONBody(in), out)
+}
+
+// PutJSON sends a PUT request with in as the JSON body and decodes the
+// response into out. See GetJSON for status and decode semantics.
+func (c *Client) PutJSON(ctx context.Context, path string, in, out interface{}) (*Response, error) {
+	return sendAndScan(ctx, c.Put(path).JSONBody(in), out)
+}
+
+// DeleteJSON sends a DELETE request and decodes the response into out. See
+// GetJSON for status and decode semantics.
+func (c *Client) DeleteJSON(ctx context.Context, path string, out interface{}) (*Response, error) {
+	return sendAndScan(ctx, c.Delete(path), out)
+}
+
+// sendAndScan executes the built request, rejects non-2xx statuses, and
+// decodes the body into out when requested.
+func sendAndScan(ctx context.Context, builder *RequestBuilder, out interface{}) (*Response, error) {
+	resp, err := builder.Send(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if !resp.IsSuccess() {
+		return resp, fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode())
+	}
+	if out != nil {
+		if err := resp.Scan(out); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
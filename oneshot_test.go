@@ -0,0 +1,91 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetJSONDecodesBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"alice"}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	var out struct {
+		Name string `json:"name"`
+	}
+	resp, err := client.GetJSON(context.Background(), "/users/1", &out)
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, "alice", out.Name)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+}
+
+func TestPostJSONRoundTrip(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "7", "name": in["name"]})
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	var out struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	resp, err := client.PostJSON(context.Background(), "/users", map[string]string{"name": "bob"}, &out)
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, "7", out.ID)
+	assert.Equal(t, "bob", out.Name)
+}
+
+func TestGetJSONRejectsErrorStatus(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not found"}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	var out map[string]string
+	resp, err := client.GetJSON(context.Background(), "/missing", &out)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnexpectedStatus)
+	assert.Empty(t, out, "body is not decoded on error statuses")
+
+	// The response is still available for inspecting the error body.
+	require.NotNil(t, resp)
+	defer resp.Close() //nolint:errcheck
+	assert.Contains(t, resp.String(), "not found")
+}
+
+func TestGetJSONNilOutSkipsDecode(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not json"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	resp, err := client.GetJSON(context.Background(), "/", nil)
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+}
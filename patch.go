@@ -0,0 +1,27 @@
+package requests
+
+// Content types for the two standard JSON patch formats.
+const (
+	jsonPatchContentType  = "application/json-patch+json"
+	mergePatchContentType = "application/merge-patch+json"
+)
+
+// Operation is a single RFC 6902 JSON Patch operation.
+type Operation struct {
+	Op    string      `json:"op"`              // One of add, remove, replace, move, copy, test.
+	Path  string      `json:"path"`            // JSON Pointer to the target location.
+	From  string      `json:"from,omitempty"`  // Source location for move and copy.
+	Value interface{} `json:"value,omitempty"` // Value for add, replace, and test.
+}
+
+// PatchJSON initiates a PATCH request carrying an RFC 6902 JSON Patch with
+// the application/json-patch+json content type.
+func (c *Client) PatchJSON(path string, patch []Operation) *RequestBuilder {
+	return c.Patch(path).ContentType(jsonPatchContentType).Body(patch)
+}
+
+// MergePatch initiates a PATCH request carrying an RFC 7396 JSON Merge Patch
+// encoded from v with the application/merge-patch+json content type.
+func (c *Client) MergePatch(path string, v interface{}) *RequestBuilder {
+	return c.Patch(path).ContentType(mergePatchContentType).Body(v)
+}
@@ -0,0 +1,61 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchJSON(t *testing.T) {
+	var gotContentType string
+	var gotBody []byte
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.PatchJSON("/resource", []Operation{
+		{Op: "replace", Path: "/name", Value: "updated"},
+		{Op: "remove", Path: "/obsolete"},
+	}).Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	assert.Equal(t, "application/json-patch+json", gotContentType)
+
+	var ops []Operation
+	require.NoError(t, json.Unmarshal(gotBody, &ops))
+	require.Len(t, ops, 2)
+	assert.Equal(t, "replace", ops[0].Op)
+	assert.Equal(t, "/name", ops[0].Path)
+	assert.Equal(t, "updated", ops[0].Value)
+}
+
+func TestMergePatch(t *testing.T) {
+	var gotContentType string
+	var gotBody []byte
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.MergePatch("/resource", map[string]interface{}{
+		"name":     "updated",
+		"obsolete": nil,
+	}).Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	assert.Equal(t, "application/merge-patch+json", gotContentType)
+	assert.JSONEq(t, `{"name":"updated","obsolete":null}`, string(gotBody))
+}
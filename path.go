@@ -0,0 +1,126 @@
+package requests
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// buildPath expands a path template using the given params.
+//
+// Placeholders use the `{name}` syntax. A trailing `?` marks the segment as
+// optional: `{name?}` expands to the value when present and drops the segment
+// entirely when absent. A trailing `*` marks a wildcard (splat) param:
+// `{name*}` may expand to multiple path segments, each escaped individually.
+//
+// buildPath returns an error when a required placeholder has no value or when
+// a supplied param does not appear in the template.
+func buildPath(template string, params map[string]string) (string, error) {
+	used := make(map[string]bool, len(params))
+
+	segments := strings.Split(template, "/")
+	result := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			// Literal segment; may still contain inline placeholders.
+			expanded, err := expandInline(segment, params, used)
+			if err != nil {
+				return "", err
+			}
+			result = append(result, expanded)
+			continue
+		}
+
+		name := segment[1 : len(segment)-1]
+		optional := strings.HasSuffix(name, "?")
+		wildcard := strings.HasSuffix(name, "*")
+		if optional || wildcard {
+			name = name[:len(name)-1]
+		}
+
+		value, ok := params[name]
+		switch {
+		case !ok && optional:
+			// Drop the segment entirely.
+			continue
+		case !ok:
+			return "", fmt.Errorf("%w: %s", ErrMissingPathParam, name)
+		}
+		used[name] = true
+
+		if wildcard {
+			// Escape each sub-segment individually so slashes survive.
+			parts := strings.Split(value, "/")
+			for i, part := range parts {
+				parts[i] = url.PathEscape(part)
+			}
+			result = append(result, strings.Join(parts, "/"))
+		} else {
+			result = append(result, url.PathEscape(value))
+		}
+	}
+
+	for name := range params {
+		if !used[name] {
+			return "", fmt.Errorf("%w: %s", ErrUnusedPathParam, name)
+		}
+	}
+
+	return strings.Join(result, "/"), nil
+}
+
+// expandInline replaces `{name}` placeholders embedded inside a literal
+// segment, erroring on placeholders that have no matching param.
+func expandInline(segment string, params map[string]string, used map[string]bool) (string, error) {
+	for {
+		start := strings.Index(segment, "{")
+		if start == -1 {
+			return segment, nil
+		}
+		end := strings.Index(segment[start:], "}")
+		if end == -1 {
+			return segment, nil
+		}
+		end += start
+
+		name := segment[start+1 : end]
+		value, ok := params[name]
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrMissingPathParam, name)
+		}
+		used[name] = true
+		segment = segment[:start] + url.PathEscape(value) + segment[end+1:]
+	}
+}
+
+// BuildURL constructs a full URL from the client's base URL, a path template,
+// path params, and query parameters without sending a request. It uses the
+// same template syntax as RequestBuilder path params.
+func (c *Client) BuildURL(pathTemplate string, params map[string]string, query url.Values) (string, error) {
+	path, err := buildPath(pathTemplate, params)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	parsedURL, err := resolveAgainstBase(c.getBaseURL(), ref)
+	if err != nil {
+		return "", err
+	}
+
+	if query != nil {
+		merged := parsedURL.Query()
+		for key, values := range query {
+			for _, value := range values {
+				merged.Add(key, value)
+			}
+		}
+		parsedURL.RawQuery = merged.Encode()
+	}
+
+	return parsedURL.String(), nil
+}
@@ -0,0 +1,106 @@
+package requests
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		params   map[string]string
+		expected string
+		err      error
+	}{
+		{
+			name:     "simple params",
+			template: "/users/{userId}/posts/{postId}",
+			params:   map[string]string{"userId": "johnDoe", "postId": "123"},
+			expected: "/users/johnDoe/posts/123",
+		},
+		{
+			name:     "escaped value",
+			template: "/files/{name}",
+			params:   map[string]string{"name": "a b/c"},
+			expected: "/files/a%20b%2Fc",
+		},
+		{
+			name:     "optional segment present",
+			template: "/users/{userId}/{section?}",
+			params:   map[string]string{"userId": "1", "section": "posts"},
+			expected: "/users/1/posts",
+		},
+		{
+			name:     "optional segment absent",
+			template: "/users/{userId}/{section?}",
+			params:   map[string]string{"userId": "1"},
+			expected: "/users/1",
+		},
+		{
+			name:     "wildcard param keeps slashes",
+			template: "/static/{path*}",
+			params:   map[string]string{"path": "css/site main.css"},
+			expected: "/static/css/site%20main.css",
+		},
+		{
+			name:     "missing param",
+			template: "/users/{userId}",
+			params:   map[string]string{},
+			err:      ErrMissingPathParam,
+		},
+		{
+			name:     "unused param",
+			template: "/users/{userId}",
+			params:   map[string]string{"userId": "1", "extra": "x"},
+			err:      ErrUnusedPathParam,
+		},
+		{
+			name:     "inline placeholder",
+			template: "/reports/report-{id}.pdf",
+			params:   map[string]string{"id": "42"},
+			expected: "/reports/report-42.pdf",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := buildPath(tc.template, tc.params)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestClientBuildURL(t *testing.T) {
+	client := URL("https://api.example.com")
+
+	built, err := client.BuildURL("/users/{userId}", map[string]string{"userId": "42"}, url.Values{"page": {"2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/users/42?page=2", built)
+
+	// Errors surface without sending anything.
+	_, err = client.BuildURL("/users/{userId}", nil, nil)
+	assert.ErrorIs(t, err, ErrMissingPathParam)
+}
+
+func TestClientBuildURLJoinsLikePrepareURL(t *testing.T) {
+	// A trailing-slash base plus a leading-slash template must not produce a
+	// doubled slash, and a path prefix on the base is kept.
+	client := URL("https://api.example.com/v1/")
+
+	built, err := client.BuildURL("/users/{userId}", map[string]string{"userId": "42"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1/users/42", built)
+
+	client = URL("https://api.example.com/v1")
+	built, err = client.BuildURL("/users/{userId}", map[string]string{"userId": "42"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1/users/42", built)
+}
@@ -0,0 +1,54 @@
+package requests
+
+import (
+	"bufio"
+	"net/http"
+)
+
+// minPeekBuffer is the smallest read-ahead buffer used for peeking into
+// unbuffered bodies; 512 bytes is what http.DetectContentType sniffs.
+const minPeekBuffer = 512
+
+// Peek returns up to n bytes from the start of the body without consuming
+// them, so routing decisions (is this JSON or an HTML error page?) can be
+// made before committing to a decode strategy. Buffered responses peek into
+// the in-memory body. Unbuffered responses read ahead through a buffer that
+// later decoders continue from, so no data is lost; for those, peeks larger
+// than the first peek's buffer return only the buffered prefix.
+func (r *Response) Peek(n int) []byte {
+	if n <= 0 {
+		return nil
+	}
+
+	if r.BodyBytes != nil {
+		if n > len(r.BodyBytes) {
+			n = len(r.BodyBytes)
+		}
+		return r.BodyBytes[:n]
+	}
+
+	if r.RawResponse == nil || r.RawResponse.Body == nil {
+		return nil
+	}
+
+	r.peekMu.Lock()
+	defer r.peekMu.Unlock()
+
+	if r.peeker == nil {
+		size := n
+		if size < minPeekBuffer {
+			size = minPeekBuffer
+		}
+		r.peeker = bufio.NewReaderSize(r.RawResponse.Body, size)
+	}
+
+	data, _ := r.peeker.Peek(n)
+	return data
+}
+
+// DetectContentType sniffs the body's actual content type from its first 512
+// bytes via http.DetectContentType, independent of the Content-Type header.
+// It works in both buffered and unbuffered mode without consuming the body.
+func (r *Response) DetectContentType() string {
+	return http.DetectContentType(r.Peek(minPeekBuffer))
+}
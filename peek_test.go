@@ -0,0 +1,70 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeekBufferedBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"kind":"json"}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, []byte(`{"kind"`), resp.Peek(7))
+	assert.Equal(t, `{"kind":"json"}`, string(resp.Peek(1024)), "oversized peeks are clamped")
+	assert.Empty(t, resp.Peek(0))
+
+	// The body is still fully readable afterwards.
+	assert.Equal(t, `{"kind":"json"}`, resp.String())
+}
+
+func TestPeekUnbufferedBodyDoesNotConsume(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"alice","age":30}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Unbuffered().Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, []byte(`{`), resp.Peek(1))
+
+	// Streaming decode still sees the whole document, including the peeked byte.
+	var out struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+	require.NoError(t, resp.ScanJSONStream(&out))
+	assert.Equal(t, "alice", out.Name)
+	assert.Equal(t, 30, out.Age)
+}
+
+func TestDetectContentType(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Deliberately wrong header; sniffing should see HTML.
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("<!DOCTYPE html><html><body>Service degraded</body></html>"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Contains(t, resp.DetectContentType(), "text/html")
+}
@@ -0,0 +1,219 @@
+package requests
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultCookieProfile is the profile PersistentCookies stores under when the
+// caller does not pick one.
+const defaultCookieProfile = "default"
+
+// persistedCookie is the serialized form of a cookie in the encrypted store.
+type persistedCookie struct {
+	Name     string    `json:"name"`
+	Value    string    `json:"value"`
+	Path     string    `json:"path,omitempty"`
+	Domain   string    `json:"domain,omitempty"`
+	Expires  time.Time `json:"expires,omitempty"`
+	Secure   bool      `json:"secure,omitempty"`
+	HTTPOnly bool      `json:"http_only,omitempty"`
+}
+
+// cookieStoreFile is the decrypted file layout: cookies per host, per profile,
+// so several profiles (accounts, environments) share one store file.
+type cookieStoreFile map[string]map[string][]persistedCookie
+
+// persistentCookieStore holds one profile's cookies and writes the encrypted
+// store file back whenever a response updates them.
+type persistentCookieStore struct {
+	path    string
+	profile string
+	aead    cipher.AEAD
+
+	mu      sync.Mutex
+	cookies map[string][]persistedCookie // keyed by request host
+}
+
+// PersistentCookies returns a middleware that persists cookies to an
+// encrypted file, so CLI tools keep login sessions between runs without
+// storing them in plain text. Cookies from the store are attached to outgoing
+// requests by host, and every response carrying Set-Cookie updates the file.
+// The key must be 16, 24 or 32 bytes (AES-128/192/256); the file is sealed
+// with AES-GCM.
+func PersistentCookies(path string, key []byte) (Middleware, error) {
+	return PersistentCookiesProfile(path, key, defaultCookieProfile)
+}
+
+// PersistentCookiesProfile is PersistentCookies bound to a named profile, so
+// one store file can hold independent sessions (e.g. per account).
+func PersistentCookiesProfile(path string, key []byte, profile string) (Middleware, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+
+	store := &persistentCookieStore{path: path, profile: profile, aead: aead}
+	if err := store.load(); err != nil {
+		return nil, err
+	}
+	return store.middleware, nil
+}
+
+// middleware attaches stored cookies before the call and persists cookie
+// updates after it.
+func (s *persistentCookieStore) middleware(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		s.attachCookies(req)
+
+		resp, err := next(req)
+		if err != nil {
+			return resp, err
+		}
+
+		if cookies := resp.Cookies(); len(cookies) > 0 {
+			if saveErr := s.update(req.URL.Host, cookies); saveErr != nil {
+				return resp, saveErr
+			}
+		}
+		return resp, nil
+	}
+}
+
+// attachCookies adds the stored cookies for the request's host, skipping
+// expired ones and honoring the Secure attribute.
+func (s *persistentCookieStore) attachCookies(req *http.Request) {
+	s.mu.Lock()
+	stored := s.cookies[req.URL.Host]
+	s.mu.Unlock()
+
+	for _, cookie := range stored {
+		addRequestCookie(req, &http.Cookie{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Path:     cookie.Path,
+			Domain:   cookie.Domain,
+			Expires:  cookie.Expires,
+			Secure:   cookie.Secure,
+			HttpOnly: cookie.HTTPOnly,
+		})
+	}
+}
+
+// update merges the response cookies into the host's stored cookies and
+// writes the store file. Cookies expired by the server are dropped.
+func (s *persistentCookieStore) update(host string, cookies []*http.Cookie) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cookies == nil {
+		s.cookies = make(map[string][]persistedCookie)
+	}
+	stored := s.cookies[host]
+	for _, cookie := range cookies {
+		updated := persistedCookie{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Path:     cookie.Path,
+			Domain:   cookie.Domain,
+			Expires:  cookie.Expires,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HttpOnly,
+		}
+		expired := !cookie.Expires.IsZero() && cookie.Expires.Before(time.Now())
+
+		replaced := false
+		for i := range stored {
+			if stored[i].Name == cookie.Name {
+				if expired {
+					stored = append(stored[:i], stored[i+1:]...)
+				} else {
+					stored[i] = updated
+				}
+				replaced = true
+				break
+			}
+		}
+		if !replaced && !expired {
+			stored = append(stored, updated)
+		}
+	}
+	s.cookies[host] = stored
+
+	return s.saveLocked()
+}
+
+// load decrypts the store file and keeps this profile's cookies; a missing
+// file starts an empty store.
+func (s *persistentCookieStore) load() error {
+	file, err := s.readFile()
+	if err != nil {
+		return err
+	}
+	s.cookies = file[s.profile]
+	return nil
+}
+
+// readFile reads and decrypts the whole store file, all profiles included.
+func (s *persistentCookieStore) readFile() (cookieStoreFile, error) {
+	sealed, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cookieStoreFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, fmt.Errorf("%w: store file is truncated", ErrCookieStoreFailed)
+	}
+	plain, err := s.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+
+	var file cookieStoreFile
+	if err := json.Unmarshal(plain, &file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+	return file, nil
+}
+
+// saveLocked re-reads the store file to preserve other profiles, replaces
+// this profile, and writes the encrypted result. Callers hold s.mu.
+func (s *persistentCookieStore) saveLocked() error {
+	file, err := s.readFile()
+	if err != nil {
+		return err
+	}
+	file[s.profile] = s.cookies
+
+	plain, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+	sealed := s.aead.Seal(nonce, nonce, plain, nil)
+
+	if err := os.WriteFile(s.path, sealed, 0o600); err != nil {
+		return fmt.Errorf("%w: %v", ErrCookieStoreFailed, err) //nolint:errorlint
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cookieEchoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "s3cret"})
+		case "/whoami":
+			if cookie, err := r.Cookie("session"); err == nil {
+				_, _ = w.Write([]byte(cookie.Value))
+			}
+		}
+	}))
+	t.Cleanup(mockServer.Close)
+	return mockServer
+}
+
+func TestPersistentCookiesKeepsSessionAcrossClients(t *testing.T) {
+	mockServer := cookieEchoServer(t)
+	path := filepath.Join(t.TempDir(), "cookies.enc")
+	key := []byte("0123456789abcdef") // AES-128
+
+	persist, err := PersistentCookies(path, key)
+	require.NoError(t, err)
+
+	first := Create(&Config{BaseURL: mockServer.URL, Middlewares: []Middleware{persist}})
+	resp, err := first.Get("/login").Send(context.Background())
+	require.NoError(t, err)
+	_ = resp.Close()
+
+	// A fresh client with a store built from the same file sees the session.
+	persist2, err := PersistentCookies(path, key)
+	require.NoError(t, err)
+	second := Create(&Config{BaseURL: mockServer.URL, Middlewares: []Middleware{persist2}})
+
+	resp, err = second.Get("/whoami").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+	assert.Equal(t, "s3cret", resp.String())
+}
+
+func TestPersistentCookiesProfilesAreIndependent(t *testing.T) {
+	mockServer := cookieEchoServer(t)
+	path := filepath.Join(t.TempDir(), "cookies.enc")
+	key := []byte("0123456789abcdef0123456789abcdef") // AES-256
+
+	alice, err := PersistentCookiesProfile(path, key, "alice")
+	require.NoError(t, err)
+	client := Create(&Config{BaseURL: mockServer.URL, Middlewares: []Middleware{alice}})
+	resp, err := client.Get("/login").Send(context.Background())
+	require.NoError(t, err)
+	_ = resp.Close()
+
+	bob, err := PersistentCookiesProfile(path, key, "bob")
+	require.NoError(t, err)
+	client = Create(&Config{BaseURL: mockServer.URL, Middlewares: []Middleware{bob}})
+	resp, err = client.Get("/whoami").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Empty(t, resp.String(), "bob's profile must not see alice's session")
+}
+
+func TestPersistentCookiesRejectsBadKey(t *testing.T) {
+	_, err := PersistentCookies("unused", []byte("short"))
+	require.ErrorIs(t, err, ErrCookieStoreFailed)
+}
+
+func TestPersistentCookiesWrongKeyFailsClosed(t *testing.T) {
+	mockServer := cookieEchoServer(t)
+	path := filepath.Join(t.TempDir(), "cookies.enc")
+
+	persist, err := PersistentCookies(path, []byte("0123456789abcdef"))
+	require.NoError(t, err)
+	client := Create(&Config{BaseURL: mockServer.URL, Middlewares: []Middleware{persist}})
+	resp, err := client.Get("/login").Send(context.Background())
+	require.NoError(t, err)
+	_ = resp.Close()
+
+	_, err = PersistentCookies(path, []byte("fedcba9876543210"))
+	require.ErrorIs(t, err, ErrCookieStoreFailed)
+}
@@ -0,0 +1,125 @@
+package requests
+
+import (
+	"net/http"
+	"time"
+)
+
+// ClientOption applies one piece of configuration to a client. Presets
+// return bundles of options; Apply installs them.
+type ClientOption func(*Client)
+
+// Apply runs the given options against the client, in order. Combined with
+// the Profile presets it replaces hand-copied transport tuning snippets:
+//
+//	client := requests.URL("https://api.example.com")
+//	client.Apply(requests.ProfileLowLatency()...)
+func (c *Client) Apply(options ...ClientOption) *Client {
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// ProfileLowLatency tunes the client for interactive, latency-sensitive
+// calls: a short overall timeout, tight dial and header deadlines, a warm
+// per-host connection pool so requests rarely pay a handshake, and a single
+// quick retry.
+func ProfileLowLatency() []ClientOption {
+	return []ClientOption{
+		withTimeout(5 * time.Second),
+		withTransport(func(t *http.Transport) {
+			t.MaxIdleConns = 200
+			t.MaxIdleConnsPerHost = 100
+			t.IdleConnTimeout = 90 * time.Second
+			t.TLSHandshakeTimeout = 5 * time.Second
+			t.ResponseHeaderTimeout = 3 * time.Second
+			t.ForceAttemptHTTP2 = true
+		}),
+		func(c *Client) {
+			c.SetMaxRetries(1)
+			c.SetRetryStrategy(LinearBackoffStrategy(100 * time.Millisecond))
+		},
+	}
+}
+
+// ProfileHighThroughput tunes the client for bulk traffic to a few hosts:
+// large idle pools so connections are reused across many concurrent
+// requests, bigger socket buffers, generous timeouts, and a couple of
+// backed-off retries.
+func ProfileHighThroughput() []ClientOption {
+	return []ClientOption{
+		withTimeout(60 * time.Second),
+		withTransport(func(t *http.Transport) {
+			t.MaxIdleConns = 512
+			t.MaxIdleConnsPerHost = 256
+			t.IdleConnTimeout = 120 * time.Second
+			t.WriteBufferSize = 64 << 10
+			t.ReadBufferSize = 64 << 10
+			t.ForceAttemptHTTP2 = true
+		}),
+		func(c *Client) {
+			c.SetMaxRetries(2)
+			c.SetRetryStrategy(ExponentialBackoffStrategy(500*time.Millisecond, 2, 10*time.Second))
+		},
+	}
+}
+
+// ProfileScraping tunes the client for polite crawling of many third-party
+// hosts: a browser-like User-Agent, few idle connections per host, patient
+// timeouts, compression enabled, and persistent retries with exponential
+// backoff for flaky servers.
+func ProfileScraping() []ClientOption {
+	return []ClientOption{
+		withTimeout(30 * time.Second),
+		withTransport(func(t *http.Transport) {
+			t.MaxIdleConns = 100
+			t.MaxIdleConnsPerHost = 2
+			t.IdleConnTimeout = 30 * time.Second
+			t.DisableCompression = false
+		}),
+		func(c *Client) {
+			c.SetDefaultUserAgent("Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36")
+			c.SetMaxRetries(3)
+			c.SetRetryStrategy(ExponentialBackoffStrategy(1*time.Second, 2, 30*time.Second))
+		},
+	}
+}
+
+// withTimeout sets the overall per-request timeout on the underlying
+// http.Client.
+func withTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.HTTPClient == nil {
+			c.HTTPClient = &http.Client{}
+		}
+		c.HTTPClient.Timeout = timeout
+	}
+}
+
+// withTransport mutates the client's *http.Transport, creating one when the
+// client still rides on the default. Custom non-http.Transport round
+// trippers are left untouched, since the preset cannot know how to tune
+// them.
+func withTransport(mutate func(*http.Transport)) ClientOption {
+	return func(c *Client) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.HTTPClient == nil {
+			c.HTTPClient = &http.Client{}
+		}
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			if c.HTTPClient.Transport != nil {
+				return
+			}
+			transport = &http.Transport{TLSClientConfig: c.TLSConfig}
+			c.HTTPClient.Transport = transport
+		}
+		mutate(transport)
+	}
+}
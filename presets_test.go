@@ -0,0 +1,65 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clientTransport(t *testing.T, client *Client) *http.Transport {
+	t.Helper()
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok, "expected *http.Transport")
+	return transport
+}
+
+func TestProfileLowLatency(t *testing.T) {
+	client := Create(nil).Apply(ProfileLowLatency()...)
+
+	transport := clientTransport(t, client)
+	assert.Equal(t, 100, transport.MaxIdleConnsPerHost)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.Equal(t, 5*time.Second, client.HTTPClient.Timeout)
+	assert.Equal(t, 1, client.MaxRetries)
+}
+
+func TestProfileHighThroughput(t *testing.T) {
+	client := Create(nil).Apply(ProfileHighThroughput()...)
+
+	transport := clientTransport(t, client)
+	assert.Equal(t, 512, transport.MaxIdleConns)
+	assert.Equal(t, 256, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 64<<10, transport.WriteBufferSize)
+	assert.Equal(t, 2, client.MaxRetries)
+}
+
+func TestProfileScraping(t *testing.T) {
+	client := Create(nil).Apply(ProfileScraping()...)
+
+	transport := clientTransport(t, client)
+	assert.Equal(t, 2, transport.MaxIdleConnsPerHost)
+	assert.False(t, transport.DisableCompression)
+	assert.Equal(t, 3, client.MaxRetries)
+	assert.Contains(t, client.Headers.Get("User-Agent"), "Mozilla/5.0")
+}
+
+func TestPresetPreservesCustomRoundTripper(t *testing.T) {
+	custom := http.NewFileTransport(http.Dir("."))
+	client := Create(&Config{Transport: custom})
+	client.Apply(ProfileHighThroughput()...)
+
+	assert.Equal(t, custom, client.HTTPClient.Transport)
+}
+
+func TestApplyRunsOptionsInOrder(t *testing.T) {
+	var order []string
+	client := Create(nil)
+	client.Apply(
+		func(*Client) { order = append(order, "first") },
+		func(*Client) { order = append(order, "second") },
+	)
+	assert.Equal(t, []string{"first", "second"}, order)
+}
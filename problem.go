@@ -0,0 +1,91 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+// Content types carrying RFC 9457 problem details bodies.
+const (
+	problemJSONContentType = "application/problem+json"
+	problemXMLContentType  = "application/problem+xml"
+)
+
+// ProblemDetails is an RFC 9457 problem details error body. It implements the
+// error interface so it can be returned directly as the error value.
+type ProblemDetails struct {
+	XMLName  xml.Name `json:"-" xml:"problem"`
+	Type     string   `json:"type,omitempty" xml:"type,omitempty"`
+	Title    string   `json:"title,omitempty" xml:"title,omitempty"`
+	Status   int      `json:"status,omitempty" xml:"status,omitempty"`
+	Detail   string   `json:"detail,omitempty" xml:"detail,omitempty"`
+	Instance string   `json:"instance,omitempty" xml:"instance,omitempty"`
+
+	// Extensions holds members beyond the standard fields. Only populated for
+	// JSON bodies.
+	Extensions map[string]interface{} `json:"-" xml:"-"`
+}
+
+// problemStandardFields are the member names defined by RFC 9457; everything
+// else in a problem+json body is an extension.
+var problemStandardFields = []string{"type", "title", "status", "detail", "instance"}
+
+// Error implements the error interface.
+func (p *ProblemDetails) Error() string {
+	msg := p.Title
+	if msg == "" {
+		msg = p.Type
+	}
+	if msg == "" {
+		msg = http.StatusText(p.Status)
+	}
+	if p.Detail != "" {
+		msg += ": " + p.Detail
+	}
+	return fmt.Sprintf("problem (status %d): %s", p.Status, msg)
+}
+
+// IsProblem reports whether the response carries an RFC 9457 problem details
+// body.
+func (r *Response) IsProblem() bool {
+	return r.IsContentType(problemJSONContentType) || r.IsContentType(problemXMLContentType)
+}
+
+// ProblemDetails decodes an RFC 9457 problem details body into a typed struct.
+// JSON extension members beyond the standard fields are collected into
+// Extensions. When the body omits the status member, the response status code
+// is used.
+func (r *Response) ProblemDetails() (*ProblemDetails, error) {
+	problem := &ProblemDetails{}
+
+	switch {
+	case r.IsContentType(problemJSONContentType):
+		if err := r.Client.JSONDecoder.Decode(bytes.NewReader(r.BodyBytes), problem); err != nil {
+			return nil, err
+		}
+
+		// Collect extension members.
+		var raw map[string]interface{}
+		if err := r.Client.JSONDecoder.Decode(bytes.NewReader(r.BodyBytes), &raw); err == nil {
+			for _, field := range problemStandardFields {
+				delete(raw, field)
+			}
+			if len(raw) > 0 {
+				problem.Extensions = raw
+			}
+		}
+	case r.IsContentType(problemXMLContentType):
+		if err := r.Client.XMLDecoder.Decode(bytes.NewReader(r.BodyBytes), problem); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedContentType, r.ContentType())
+	}
+
+	if problem.Status == 0 {
+		problem.Status = r.StatusCode()
+	}
+	return problem, nil
+}
@@ -0,0 +1,101 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblemDetailsJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"type":"https://example.com/probs/out-of-credit","title":"You do not have enough credit.","status":403,"detail":"Your current balance is 30.","instance":"/account/12345","balance":30}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.True(t, resp.IsProblem())
+
+	problem, err := resp.ProblemDetails()
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/probs/out-of-credit", problem.Type)
+	assert.Equal(t, "You do not have enough credit.", problem.Title)
+	assert.Equal(t, http.StatusForbidden, problem.Status)
+	assert.Equal(t, "Your current balance is 30.", problem.Detail)
+	assert.Equal(t, "/account/12345", problem.Instance)
+	assert.Equal(t, float64(30), problem.Extensions["balance"])
+}
+
+func TestProblemDetailsXML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+xml")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `<problem><title>Not Found</title><detail>No such user.</detail></problem>`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	problem, err := resp.ProblemDetails()
+	require.NoError(t, err)
+	assert.Equal(t, "Not Found", problem.Title)
+	assert.Equal(t, "No such user.", problem.Detail)
+	// The status member falls back to the response status code.
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+}
+
+func TestProblemDetailsUnsupportedContentType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.False(t, resp.IsProblem())
+	_, err = resp.ProblemDetails()
+	assert.ErrorIs(t, err, ErrUnsupportedContentType)
+}
+
+func TestFailOnProblem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"title":"Conflict","detail":"Already exists."}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL}).SetFailOnProblem(true)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	defer resp.Close() //nolint: errcheck
+
+	var problem *ProblemDetails
+	require.True(t, errors.As(err, &problem))
+	assert.Equal(t, "Conflict", problem.Title)
+	assert.Equal(t, http.StatusConflict, problem.Status)
+	assert.Contains(t, problem.Error(), "Already exists.")
+}
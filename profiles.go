@@ -0,0 +1,118 @@
+package requests
+
+import (
+	"math/rand"
+	"net/http"
+)
+
+// headerProfiles holds coherent browser header sets keyed by profile name, so
+// rotated requests don't pair a Chrome user agent with non-Chrome hints.
+var headerProfiles = map[string]http.Header{
+	"chrome-mac": {
+		"User-Agent":         {"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"},
+		"Accept":             {"text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8"},
+		"Accept-Language":    {"en-US,en;q=0.9"},
+		"Sec-Ch-Ua":          {`"Chromium";v="124", "Google Chrome";v="124", "Not-A.Brand";v="99"`},
+		"Sec-Ch-Ua-Mobile":   {"?0"},
+		"Sec-Ch-Ua-Platform": {`"macOS"`},
+	},
+	"chrome-windows": {
+		"User-Agent":         {"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"},
+		"Accept":             {"text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8"},
+		"Accept-Language":    {"en-US,en;q=0.9"},
+		"Sec-Ch-Ua":          {`"Chromium";v="124", "Google Chrome";v="124", "Not-A.Brand";v="99"`},
+		"Sec-Ch-Ua-Mobile":   {"?0"},
+		"Sec-Ch-Ua-Platform": {`"Windows"`},
+	},
+	"firefox-linux": {
+		"User-Agent":      {"Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0"},
+		"Accept":          {"text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8"},
+		"Accept-Language": {"en-US,en;q=0.5"},
+	},
+	"safari-mac": {
+		"User-Agent":      {"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15"},
+		"Accept":          {"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"},
+		"Accept-Language": {"en-US,en;q=0.9"},
+	},
+}
+
+// HeaderProfiles returns the names of the built-in header profiles.
+func HeaderProfiles() []string {
+	names := make([]string, 0, len(headerProfiles))
+	for name := range headerProfiles {
+		names = append(names, name)
+	}
+	return names
+}
+
+// SetUserAgentRotation configures a pool of user agent strings. With
+// perRequest true the client rotates through the pool round-robin on every
+// request; otherwise one agent is picked at random now and used for all
+// requests. An empty pool disables rotation.
+func (c *Client) SetUserAgentRotation(agents []string, perRequest bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.userAgents = make([]string, len(agents))
+	copy(c.userAgents, agents)
+	c.uaRotatePerRequest = perRequest
+	if !perRequest && len(c.userAgents) > 0 {
+		c.uaIndex = rand.Intn(len(c.userAgents)) //nolint:gosec // Not used for security.
+	} else {
+		c.uaIndex = 0
+	}
+	return c
+}
+
+// UseHeaderProfile applies a named built-in header profile to every request.
+// It returns an error if the profile name is unknown; see HeaderProfiles for
+// the available names.
+func (c *Client) UseHeaderProfile(name string) error {
+	profile, ok := headerProfiles[name]
+	if !ok {
+		return ErrUnknownHeaderProfile
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.headerProfile = profile
+	return nil
+}
+
+// nextUserAgent returns the user agent for the next request, advancing the
+// rotation when configured per request, or "" when rotation is disabled.
+func (c *Client) nextUserAgent() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.userAgents) == 0 {
+		return ""
+	}
+	agent := c.userAgents[c.uaIndex%len(c.userAgents)]
+	if c.uaRotatePerRequest {
+		c.uaIndex++
+	}
+	return agent
+}
+
+// applyProfileHeaders fills in profile and rotation headers without
+// overriding headers the caller set explicitly.
+func (c *Client) applyProfileHeaders(header http.Header) {
+	c.mu.RLock()
+	profile := c.headerProfile
+	c.mu.RUnlock()
+
+	// A rotated user agent takes precedence over the profile's own.
+	if header.Get("User-Agent") == "" {
+		if agent := c.nextUserAgent(); agent != "" {
+			header.Set("User-Agent", agent)
+		}
+	}
+	for key, values := range profile {
+		if header.Get(key) == "" {
+			for _, value := range values {
+				header.Add(key, value)
+			}
+		}
+	}
+}
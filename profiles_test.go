@@ -0,0 +1,77 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAgentRotationPerRequest(t *testing.T) {
+	var agents []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.Header.Get("User-Agent"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetUserAgentRotation([]string{"agent-a", "agent-b"}, true)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, resp.Close())
+	}
+	assert.Equal(t, []string{"agent-a", "agent-b", "agent-a"}, agents)
+}
+
+func TestUserAgentRotationSticky(t *testing.T) {
+	var agents []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.Header.Get("User-Agent"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetUserAgentRotation([]string{"agent-a", "agent-b", "agent-c"}, false)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, resp.Close())
+	}
+	require.Len(t, agents, 3)
+	assert.Equal(t, agents[0], agents[1])
+	assert.Equal(t, agents[0], agents[2])
+
+	// An explicit request header always wins over rotation.
+	resp, err := client.Get("/").UserAgent("explicit").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+	assert.Equal(t, "explicit", agents[3])
+}
+
+func TestUseHeaderProfile(t *testing.T) {
+	var got http.Header
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	require.NoError(t, client.UseHeaderProfile("chrome-mac"))
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	assert.Contains(t, got.Get("User-Agent"), "Chrome")
+	assert.Contains(t, got.Get("Sec-Ch-Ua-Platform"), "macOS")
+	assert.NotEmpty(t, got.Get("Accept-Language"))
+
+	assert.ErrorIs(t, client.UseHeaderProfile("netscape-beos"), ErrUnknownHeaderProfile)
+	assert.Contains(t, HeaderProfiles(), "chrome-mac")
+}
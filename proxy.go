@@ -22,7 +22,10 @@ func verifyProxy(proxyURL string) (*url.URL, error) {
 	}
 }
 
-// SetProxy configures the client to use a proxy. Supports http, https, and socks5 proxies.
+// SetProxy configures the client to use a proxy. Supports http, https, and
+// socks5 proxies. Failures are also recorded on the client, so a caller that
+// discards the error return still fails loudly at the next Send or Build
+// instead of issuing requests without the proxy.
 func (c *Client) SetProxy(proxyURL string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -30,6 +33,8 @@ func (c *Client) SetProxy(proxyURL string) error {
 	// Validate and parse the proxy URL
 	validatedProxyURL, err := verifyProxy(proxyURL)
 	if err != nil {
+		// Recorded directly: the client mutex is already held.
+		c.configErrs = append(c.configErrs, fmt.Errorf("proxy: %w", err))
 		return err
 	}
 
@@ -41,7 +46,9 @@ func (c *Client) SetProxy(proxyURL string) error {
 	// Assert the Transport to *http.Transport to access the Proxy field
 	transport, ok := c.HTTPClient.Transport.(*http.Transport)
 	if !ok {
-		return fmt.Errorf("%w: expected *http.Transport, got %T", ErrInvalidTransportType, c.HTTPClient.Transport)
+		err := fmt.Errorf("%w: expected *http.Transport, got %T", ErrInvalidTransportType, c.HTTPClient.Transport)
+		c.configErrs = append(c.configErrs, err)
+		return err
 	}
 
 	// Set the proxy
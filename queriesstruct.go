@@ -0,0 +1,126 @@
+package requests
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// SetQueriesStructTag changes the struct tag QueriesStruct reads, e.g.
+// "query" for structs shared with routers that already use that tag. The
+// default (and an empty value) is "url", handled by go-querystring with its
+// full option set; custom tags are handled by a built-in encoder supporting
+// basic kinds, slices, nested structs, and the omitempty and unix options.
+func (c *Client) SetQueriesStructTag(tag string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queriesStructTag = tag
+	return c
+}
+
+// getQueriesStructTag returns the tag QueriesStruct reads, defaulting to "url".
+func (c *Client) getQueriesStructTag() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.queriesStructTag == "" {
+		return "url"
+	}
+	return c.queriesStructTag
+}
+
+// structQueryValues encodes a struct into query values honoring the given
+// tag. The "url" tag goes through go-querystring; other tags use the
+// built-in encoder. time.Time fields encode as RFC 3339, or as Unix seconds
+// with the "unix" option.
+func structQueryValues(queryStruct interface{}, tag string) (url.Values, error) {
+	if tag == "url" {
+		return query.Values(queryStruct)
+	}
+
+	value := reflect.ValueOf(queryStruct)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return url.Values{}, nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: expected a struct, got %T", ErrUnsupportedDataType, queryStruct)
+	}
+
+	values := url.Values{}
+	if err := encodeStructQuery(values, value, tag); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// encodeStructQuery walks the struct fields and adds their encoded values.
+func encodeStructQuery(values url.Values, structValue reflect.Value, tag string) error {
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tagValue := field.Tag.Get(tag)
+		if tagValue == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tagValue, ",")
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldValue := structValue.Field(i)
+		for fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				fieldValue = reflect.Value{}
+				break
+			}
+			fieldValue = fieldValue.Elem()
+		}
+		if !fieldValue.IsValid() {
+			continue
+		}
+		if strings.Contains(","+options+",", ",omitempty,") && fieldValue.IsZero() {
+			continue
+		}
+
+		// Nested structs (time.Time aside) contribute their own fields.
+		if fieldValue.Kind() == reflect.Struct && fieldValue.Type() != reflect.TypeOf(time.Time{}) {
+			if err := encodeStructQuery(values, fieldValue, tag); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if fieldValue.Kind() == reflect.Slice || fieldValue.Kind() == reflect.Array {
+			for j := 0; j < fieldValue.Len(); j++ {
+				values.Add(name, formatQueryValue(fieldValue.Index(j), options))
+			}
+			continue
+		}
+		values.Add(name, formatQueryValue(fieldValue, options))
+	}
+	return nil
+}
+
+// formatQueryValue renders one field value, honoring the unix time option.
+func formatQueryValue(value reflect.Value, options string) string {
+	if t, ok := value.Interface().(time.Time); ok {
+		if strings.Contains(","+options+",", ",unix,") {
+			return strconv.FormatInt(t.Unix(), 10)
+		}
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(value.Interface())
+}
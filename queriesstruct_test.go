@@ -0,0 +1,81 @@
+package requests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueriesStructCustomTag(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+	client.SetQueriesStructTag("query")
+
+	type filter struct {
+		Name   string   `query:"name"`
+		Limit  int      `query:"limit"`
+		Tags   []string `query:"tag"`
+		Hidden string   `query:"-"`
+		Empty  string   `query:"empty,omitempty"`
+	}
+
+	req, err := client.Get("/items").
+		QueriesStruct(filter{Name: "alice", Limit: 10, Tags: []string{"a", "b"}, Hidden: "nope"}).
+		Build(context.Background())
+	require.NoError(t, err)
+
+	values := req.URL.Query()
+	assert.Equal(t, "alice", values.Get("name"))
+	assert.Equal(t, "10", values.Get("limit"))
+	assert.Equal(t, []string{"a", "b"}, values["tag"])
+	assert.NotContains(t, req.URL.RawQuery, "nope")
+	assert.NotContains(t, values, "empty")
+}
+
+func TestQueriesStructTimeFormats(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+	client.SetQueriesStructTag("query")
+
+	when := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	type filter struct {
+		Since   time.Time `query:"since"`
+		SinceTS time.Time `query:"since_ts,unix"`
+	}
+
+	req, err := client.Get("/items").
+		QueriesStruct(filter{Since: when, SinceTS: when}).
+		Build(context.Background())
+	require.NoError(t, err)
+
+	values := req.URL.Query()
+	assert.Equal(t, "2024-05-01T12:00:00Z", values.Get("since"))
+	assert.Equal(t, "1714564800", values.Get("since_ts"))
+}
+
+func TestQueriesStructSurfacesErrors(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+	client.SetQueriesStructTag("query")
+
+	err := client.Get("/items").QueriesStruct(42).Validate()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrEncodingFailed)
+
+	_, err = client.Get("/items").QueriesStruct(42).Send(context.Background())
+	assert.ErrorIs(t, err, ErrEncodingFailed)
+}
+
+func TestQueriesStructDefaultURLTag(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	type filter struct {
+		Name string `url:"name"`
+	}
+
+	req, err := client.Get("/items").
+		QueriesStruct(filter{Name: "bob"}).
+		Build(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "bob", req.URL.Query().Get("name"))
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"net/url"
+	"strings"
+)
+
+// QueryEncoding controls how query strings and form bodies are encoded, for
+// APIs that are picky about conventions url.Values leaves to interpretation.
+// The zero value keeps the standard library behavior.
+type QueryEncoding struct {
+	// SpacesAsPercent encodes spaces as %20 instead of '+', the strict RFC
+	// 3986 form. With this set and no other options, the output is fully
+	// RFC 3986 percent-encoded.
+	SpacesAsPercent bool
+	// LiteralCommaColon keeps ',' and ':' literal instead of %2C and %3A,
+	// for APIs that use them as list or range separators.
+	LiteralCommaColon bool
+}
+
+// SetQueryEncoding applies an encoding policy to query parameters (Query,
+// Queries, QueriesStruct) and application/x-www-form-urlencoded form bodies,
+// so both are encoded consistently.
+func (c *Client) SetQueryEncoding(encoding QueryEncoding) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queryEncoding = encoding
+	return c
+}
+
+// getQueryEncoding returns the encoding policy under the read lock.
+func (c *Client) getQueryEncoding() QueryEncoding {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.queryEncoding
+}
+
+// encodeQuery encodes values under the client's policy. It post-processes
+// url.Values.Encode output: at that point every remaining '+' is a space and
+// every ',' and ':' is percent-encoded, so the rewrites are unambiguous.
+func (c *Client) encodeQuery(values url.Values) string {
+	encoded := values.Encode()
+
+	policy := c.getQueryEncoding()
+	if policy.SpacesAsPercent {
+		encoded = strings.ReplaceAll(encoded, "+", "%20")
+	}
+	if policy.LiteralCommaColon {
+		encoded = strings.ReplaceAll(encoded, "%2C", ",")
+		encoded = strings.ReplaceAll(encoded, "%3A", ":")
+	}
+	return encoded
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryEncodingDefault(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/").Query("q", "a b,c:d").Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "q=a+b%2Cc%3Ad", req.URL.RawQuery)
+}
+
+func TestQueryEncodingSpacesAsPercent(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+	client.SetQueryEncoding(QueryEncoding{SpacesAsPercent: true})
+
+	req, err := client.Get("/").Query("q", "a b+c").Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "q=a%20b%2Bc", req.URL.RawQuery, "real plus signs stay encoded")
+}
+
+func TestQueryEncodingLiteralCommaColon(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+	client.SetQueryEncoding(QueryEncoding{SpacesAsPercent: true, LiteralCommaColon: true})
+
+	req, err := client.Get("/").
+		Query("fields", "id,name,created:desc").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "fields=id,name,created:desc", req.URL.RawQuery)
+}
+
+func TestQueryEncodingAppliesToFormBody(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+	client.SetQueryEncoding(QueryEncoding{SpacesAsPercent: true})
+
+	req, err := client.Post("/").
+		FormField("note", "hello world").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "note=hello%20world", string(body))
+}
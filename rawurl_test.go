@@ -0,0 +1,68 @@
+package requests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFragmentPreserved(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/docs").
+		Fragment("section-2").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "section-2", req.URL.Fragment)
+	assert.Equal(t, "http://localhost/docs#section-2", req.URL.String())
+}
+
+func TestFragmentNotReEscaped(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/docs").
+		Fragment("a%2Fb").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "http://localhost/docs#a%2Fb", req.URL.String())
+}
+
+func TestRawPathNotReEscaped(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/ignored").
+		RawPath("/v1/files/a%2Fb").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "/v1/files/a%2Fb", req.URL.EscapedPath())
+	assert.Equal(t, "http://localhost/v1/files/a%2Fb", req.URL.String())
+}
+
+func TestRawPathOpaqueBytes(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/ignored").
+		RawPath("/literal/%zz").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "/literal/%zz", req.URL.Opaque)
+	assert.Equal(t, "/literal/%zz", req.URL.RequestURI())
+}
+
+func TestRawPathKeepsQuery(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/ignored").
+		RawPath("/enc%2Foded").
+		Query("page", "2").
+		Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "http://localhost/enc%2Foded?page=2", req.URL.String())
+}
@@ -0,0 +1,93 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// statusAuthenticationTimeout is the non-standard 419 status some backends
+// use for expired sessions.
+const statusAuthenticationTimeout = 419
+
+// ReauthHandler refreshes credentials after an authentication failure. It
+// receives the 401/419 response and returns the auth method to retry with,
+// or an error to abort.
+type ReauthHandler func(ctx context.Context, resp *Response) (AuthMethod, error)
+
+// reauthManager serializes credential refreshes so parallel 401s trigger
+// only one handler invocation; late arrivals reuse the refreshed auth.
+type reauthManager struct {
+	mu         sync.Mutex
+	handler    ReauthHandler
+	generation int
+	auth       AuthMethod
+}
+
+// refresh invokes the handler once for the given auth generation. Callers
+// that observed the 401 under an older generation skip the handler because
+// another request already refreshed the credentials.
+func (m *reauthManager) refresh(ctx context.Context, resp *Response, client *Client, observed int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.generation != observed {
+		return nil
+	}
+
+	auth, err := m.handler(ctx, resp)
+	if err != nil {
+		return err
+	}
+	m.generation++
+	if auth != nil {
+		m.auth = auth
+		client.SetAuth(auth)
+	}
+	return nil
+}
+
+// currentAuth returns the most recently refreshed auth, if any.
+func (m *reauthManager) currentAuth() AuthMethod {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.auth
+}
+
+// currentGeneration returns the auth generation under the manager's lock.
+func (m *reauthManager) currentGeneration() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.generation
+}
+
+// SetReauthHandler installs a handler invoked once when a request returns
+// 401 Unauthorized or 419 Authentication Timeout. The handler can refresh
+// credentials (refresh token, re-login) and the original request is retried
+// transparently with the new auth. Parallel 401s trigger a single refresh.
+// A nil handler removes re-authentication.
+func (c *Client) SetReauthHandler(handler ReauthHandler) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if handler == nil {
+		c.reauth = nil
+		return c
+	}
+	c.reauth = &reauthManager{handler: handler}
+	return c
+}
+
+// getReauthManager returns the re-auth manager under the read lock.
+func (c *Client) getReauthManager() *reauthManager {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.reauth
+}
+
+// isAuthFailure reports whether the status code should trigger the re-auth
+// handler.
+func isAuthFailure(statusCode int) bool {
+	return statusCode == http.StatusUnauthorized || statusCode == statusAuthenticationTimeout
+}
@@ -0,0 +1,101 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReauthHandlerRetriesWithNewAuth(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer mockServer.Close()
+
+	var refreshes atomic.Int64
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetAuth(BearerAuth{Token: "stale"})
+	client.SetReauthHandler(func(ctx context.Context, resp *Response) (AuthMethod, error) {
+		refreshes.Add(1)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+		return BearerAuth{Token: "fresh"}, nil
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, "ok", resp.String())
+	assert.Equal(t, int64(1), refreshes.Load())
+
+	// The refreshed credentials stick for subsequent requests.
+	next, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, next.Close())
+	assert.Equal(t, int64(1), refreshes.Load())
+}
+
+func TestReauthHandlerSingleFlight(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer mockServer.Close()
+
+	var refreshes atomic.Int64
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetReauthHandler(func(ctx context.Context, resp *Response) (AuthMethod, error) {
+		refreshes.Add(1)
+		return BearerAuth{Token: "fresh"}, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get("/").Send(context.Background())
+			if err == nil {
+				_ = resp.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Parallel 401s observed under the same auth generation share a single
+	// handler invocation.
+	assert.Equal(t, int64(1), refreshes.Load())
+}
+
+func TestReauthHandlerGivesUpAfterOneRetry(t *testing.T) {
+	var hits atomic.Int64
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetReauthHandler(func(ctx context.Context, resp *Response) (AuthMethod, error) {
+		return BearerAuth{Token: "still-bad"}, nil
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	assert.Equal(t, int64(2), hits.Load())
+}
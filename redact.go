@@ -0,0 +1,147 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redactedPlaceholder replaces masked values in logs and dumps.
+const redactedPlaceholder = "[REDACTED]"
+
+// Redactor masks sensitive data before it reaches logs, dumps, or other
+// debugging output. Implementations must not mutate their inputs.
+type Redactor interface {
+	// RedactHeaders returns a copy of the headers with sensitive values masked.
+	RedactHeaders(header http.Header) http.Header
+	// RedactURL returns the URL string with sensitive query params masked.
+	RedactURL(u *url.URL) string
+	// RedactBody returns the body with sensitive fields masked, based on the
+	// content type.
+	RedactBody(contentType string, body []byte) []byte
+}
+
+// DefaultRedactor masks well-known credential carriers: Authorization-style
+// and cookie headers, api_key-style query parameters, and JSON body fields
+// whose names match its patterns. The match is case-insensitive.
+type DefaultRedactor struct {
+	HeaderKeys []string // Header names to mask
+	QueryKeys  []string // Query parameter names to mask
+	JSONFields []string // JSON object field names to mask, at any depth
+}
+
+// NewDefaultRedactor returns a redactor with the default sensitive key sets.
+func NewDefaultRedactor() *DefaultRedactor {
+	return &DefaultRedactor{
+		HeaderKeys: []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"},
+		QueryKeys:  []string{"api_key", "apikey", "access_token", "token", "key"},
+		JSONFields: []string{"password", "secret", "token", "api_key", "access_token", "refresh_token"},
+	}
+}
+
+// matches reports whether name equals any of the keys, ignoring case.
+func matches(keys []string, name string) bool {
+	for _, key := range keys {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactHeaders returns a copy of the headers with sensitive values masked.
+func (r *DefaultRedactor) RedactHeaders(header http.Header) http.Header {
+	masked := header.Clone()
+	for key := range masked {
+		if matches(r.HeaderKeys, key) {
+			masked[key] = []string{redactedPlaceholder}
+		}
+	}
+	return masked
+}
+
+// RedactURL returns the URL string with sensitive query params masked.
+func (r *DefaultRedactor) RedactURL(u *url.URL) string {
+	query := u.Query()
+	changed := false
+	for key := range query {
+		if matches(r.QueryKeys, key) {
+			query[key] = []string{redactedPlaceholder}
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+	masked := *u
+	masked.RawQuery = query.Encode()
+	return masked.String()
+}
+
+// RedactBody masks matching JSON object fields at any depth. Non-JSON bodies
+// are returned unchanged.
+func (r *DefaultRedactor) RedactBody(contentType string, body []byte) []byte {
+	if !strings.Contains(contentType, "json") {
+		return body
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return body
+	}
+	masked, err := json.Marshal(r.redactValue(decoded))
+	if err != nil {
+		return body
+	}
+	return masked
+}
+
+// redactValue walks a decoded JSON value, masking matching object fields.
+func (r *DefaultRedactor) redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, nested := range value {
+			if matches(r.JSONFields, key) {
+				value[key] = redactedPlaceholder
+				continue
+			}
+			value[key] = r.redactValue(nested)
+		}
+		return value
+	case []interface{}:
+		for i, nested := range value {
+			value[i] = r.redactValue(nested)
+		}
+		return value
+	default:
+		return v
+	}
+}
+
+// SetRedactor installs a redactor applied by the logging and debugging
+// features before sensitive data is written out. Pass NewDefaultRedactor()
+// for the standard masking, or nil to disable redaction.
+func (c *Client) SetRedactor(r Redactor) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.redactor = r
+	return c
+}
+
+// getRedactor returns the redactor under the read lock.
+func (c *Client) getRedactor() Redactor {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.redactor
+}
+
+// redactURLForLog masks the URL for log output when a redactor is installed.
+func (c *Client) redactURLForLog(u *url.URL) string {
+	if redactor := c.getRedactor(); redactor != nil {
+		return redactor.RedactURL(u)
+	}
+	return u.String()
+}
@@ -0,0 +1,86 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestDefaultRedactorHeaders(t *testing.T) {
+	redactor := NewDefaultRedactor()
+
+	header := http.Header{
+		"Authorization": {"Bearer secret"},
+		"Cookie":        {"session=abc"},
+		"Accept":        {"application/json"},
+	}
+	masked := redactor.RedactHeaders(header)
+
+	assert.Equal(t, "[REDACTED]", masked.Get("Authorization"))
+	assert.Equal(t, "[REDACTED]", masked.Get("Cookie"))
+	assert.Equal(t, "application/json", masked.Get("Accept"))
+	// The original headers are untouched.
+	assert.Equal(t, "Bearer secret", header.Get("Authorization"))
+}
+
+func TestDefaultRedactorURL(t *testing.T) {
+	redactor := NewDefaultRedactor()
+
+	u, err := url.Parse("https://api.example.com/v1/items?api_key=secret&page=2")
+	require.NoError(t, err)
+
+	masked := redactor.RedactURL(u)
+	assert.Contains(t, masked, "api_key=%5BREDACTED%5D")
+	assert.Contains(t, masked, "page=2")
+
+	plain, err := url.Parse("https://api.example.com/v1/items?page=2")
+	require.NoError(t, err)
+	assert.Equal(t, plain.String(), redactor.RedactURL(plain))
+}
+
+func TestDefaultRedactorBody(t *testing.T) {
+	redactor := NewDefaultRedactor()
+
+	body := []byte(`{"user":"alice","password":"hunter2","nested":{"token":"abc","keep":1},"list":[{"secret":"x"}]}`)
+	masked := redactor.RedactBody("application/json", body)
+
+	assert.JSONEq(t, `{
+		"user": "alice",
+		"password": "[REDACTED]",
+		"nested": {"token": "[REDACTED]", "keep": 1},
+		"list": [{"secret": "[REDACTED]"}]
+	}`, string(masked))
+
+	// Non-JSON and malformed bodies pass through unchanged.
+	raw := []byte("password=hunter2")
+	assert.Equal(t, raw, redactor.RedactBody("text/plain", raw))
+	broken := []byte(`{"password":`)
+	assert.Equal(t, broken, redactor.RedactBody("application/json", broken))
+}
+
+func TestRedactorAppliedToSlogOutput(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	var buf bytes.Buffer
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetSlog(slog.New(slog.NewTextHandler(&buf, nil)))
+	client.SetRedactor(NewDefaultRedactor())
+
+	resp, err := client.Get("/").Query("api_key", "secret").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	output := buf.String()
+	assert.NotContains(t, output, "secret")
+	assert.Contains(t, output, "REDACTED")
+}
@@ -0,0 +1,65 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelatedParts(t *testing.T) {
+	var gotContentType string
+	var gotBody []byte
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Post("/upload").RelatedParts(
+		&RelatedPart{
+			ContentType: "application/json",
+			Content:     strings.NewReader(`{"name":"photo.jpg"}`),
+		},
+		&RelatedPart{
+			ContentID:   "payload",
+			ContentType: "image/jpeg",
+			Content:     strings.NewReader("jpeg-bytes"),
+		},
+	).Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	mediaType, params, err := mime.ParseMediaType(gotContentType)
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/related", mediaType)
+	assert.Equal(t, "application/json", params["type"])
+	require.NotEmpty(t, params["boundary"])
+
+	// Parts arrive in order with their own content types and IDs.
+	reader := multipart.NewReader(strings.NewReader(string(gotBody)), params["boundary"])
+
+	first, err := reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", first.Header.Get("Content-Type"))
+	meta, _ := io.ReadAll(first)
+	assert.JSONEq(t, `{"name":"photo.jpg"}`, string(meta))
+
+	second, err := reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", second.Header.Get("Content-Type"))
+	assert.Equal(t, "<payload>", second.Header.Get("Content-Id"))
+	payload, _ := io.ReadAll(second)
+	assert.Equal(t, "jpeg-bytes", string(payload))
+
+	_, err = reader.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
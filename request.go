@@ -3,40 +3,94 @@ package requests
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptrace"
+	"net/textproto"
 	"net/url"
+	"os"
+	"reflect"
 	"slices"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
-
-	"github.com/google/go-querystring/query"
 )
 
 // RequestBuilder facilitates building and executing HTTP requests
 type RequestBuilder struct {
-	client        *Client
-	method        string
-	path          string
-	headers       *http.Header
-	cookies       []*http.Cookie
-	queries       url.Values
-	pathParams    map[string]string
-	formFields    url.Values
-	formFiles     []*File
-	boundary      string
-	bodyData      interface{}
-	timeout       time.Duration
-	middlewares   []Middleware
-	maxRetries    int
-	retryStrategy BackoffStrategy
-	retryIf       RetryIfFunc
-	auth          AuthMethod
-	stream        StreamCallback
-	streamErr     StreamErrCallback
-	streamDone    StreamDoneCallback
+	client            *Client
+	method            string
+	path              string
+	headers           *http.Header
+	hostHeader        string
+	cookies           []*http.Cookie
+	queries           url.Values
+	delQueries        []string
+	pathParams        map[string]string
+	formFields        url.Values
+	formFiles         []*File
+	boundary          string
+	bodyData          interface{}
+	timeout           time.Duration
+	middlewares       []Middleware
+	maxRetries        int
+	retryStrategy     BackoffStrategy
+	retryIf           RetryIfFunc
+	onRetry           RetryHook
+	auth              AuthMethod
+	stream            StreamCallback
+	streamErr         StreamErrCallback
+	streamDone        StreamDoneCallback
+	streamIdleTimeout time.Duration
+	streamResume      func(lastOffset int64) *RequestBuilder
+	streamResumeFrom  int64
+	streamResumesLeft int
+	priority          int
+	checksumAlgo      string
+	checksumHex       string
+	strictDecode      bool
+	followRedirects   *bool
+	maxRedirects      *int
+	deadlineBudget    time.Duration
+	attemptCancels    []context.CancelFunc
+	relatedParts      []*RelatedPart
+	reauthAttempted   bool
+	unbuffered        bool
+	skipMiddlewares   []string
+	tags              map[string]string
+	negotiateOffers   []string
+	fragment          string
+	rawPath           string
+	allowGetBody      bool
+	idempotencyKey    string
+	baseURLOverride   string
+	errs              []error
+}
+
+// attemptRequest caps a single attempt at the per-attempt timeout when a
+// deadline budget is in effect; the budget deadline on the parent context
+// shrinks the cap automatically as the budget runs down. Cancel functions are
+// collected on the builder and released after the response body is handled.
+func (b *RequestBuilder) attemptRequest(req *http.Request) *http.Request {
+	if b.deadlineBudget <= 0 || b.timeout <= 0 {
+		return req
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), b.timeout)
+	b.attemptCancels = append(b.attemptCancels, cancel)
+	return req.WithContext(ctx)
+}
+
+// releaseAttemptContexts cancels the per-attempt contexts created by
+// attemptRequest.
+func (b *RequestBuilder) releaseAttemptContexts() {
+	for _, cancel := range b.attemptCancels {
+		cancel()
+	}
+	b.attemptCancels = nil
 }
 
 // NewRequestBuilder creates a new RequestBuilder with default settings
@@ -100,18 +154,100 @@ func (b *RequestBuilder) DelPathParam(key ...string) *RequestBuilder {
 	return b
 }
 
-// preparePath replaces path parameters in the URL path.
-func (b *RequestBuilder) preparePath() string {
+// preparePath expands path parameters in the URL path via the template
+// engine, erroring on missing or unused params. Paths without params are
+// passed through untouched.
+func (b *RequestBuilder) preparePath() (string, error) {
 	if b.pathParams == nil {
-		return b.path
+		return b.path, nil
+	}
+
+	return buildPath(b.path, b.pathParams)
+}
+
+// prepareURL resolves the prepared path against the client's base URL.
+// Absolute URLs bypass the base URL entirely, and base URLs with trailing
+// slashes or path prefixes are joined without dropping or doubling segments.
+func (b *RequestBuilder) prepareURL(preparedPath string) (*url.URL, error) {
+	ref, err := url.Parse(preparedPath)
+	if err != nil {
+		return nil, err
 	}
 
-	preparedPath := b.path
-	for key, value := range b.pathParams {
-		placeholder := "{" + key + "}"
-		preparedPath = strings.ReplaceAll(preparedPath, placeholder, url.PathEscape(value))
+	baseURL := b.baseURLOverride
+	if baseURL == "" {
+		baseURL = b.client.getBaseURL()
+	}
+	return resolveAgainstBase(baseURL, ref)
+}
+
+// resolveAgainstBase joins a parsed request path against the base URL the
+// way sent requests do: segment-aware, keeping any path prefix on the base
+// and avoiding doubled slashes at the boundary. An absolute URL passed as
+// the path overrides the base URL, and an empty request path resolves to
+// the base URL unchanged, so the base gets no trailing slash forced onto it.
+func resolveAgainstBase(baseURL string, ref *url.URL) (*url.URL, error) {
+	if ref.IsAbs() || baseURL == "" {
+		return ref, nil
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.Path != "" && !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+		if base.RawPath != "" {
+			base.RawPath += "/"
+		}
+	}
+	ref.Path = strings.TrimPrefix(ref.Path, "/")
+	ref.RawPath = strings.TrimPrefix(ref.RawPath, "/")
+
+	return base.ResolveReference(ref), nil
+}
+
+// Fragment sets the URL fragment, which the builder otherwise has no way to
+// carry. The fragment is preserved byte for byte: already-escaped input is
+// not re-escaped. Fragments never go on the wire; they matter for Build,
+// redirects, and logging.
+func (b *RequestBuilder) Fragment(fragment string) *RequestBuilder {
+	b.fragment = fragment
+	return b
+}
+
+// RawPath replaces the request path with the given bytes exactly as written,
+// bypassing path templates and escaping. Use it for already-encoded paths
+// that must not be re-escaped; input that is not valid percent-encoding is
+// carried as an opaque URL so the bytes still reach the wire untouched.
+func (b *RequestBuilder) RawPath(rawPath string) *RequestBuilder {
+	b.rawPath = rawPath
+	return b
+}
+
+// applyRawURLParts applies the Fragment and RawPath overrides to the
+// assembled URL with exact-byte preservation.
+func (b *RequestBuilder) applyRawURLParts(parsedURL *url.URL) {
+	if b.rawPath != "" {
+		if unescaped, err := url.PathUnescape(b.rawPath); err == nil {
+			parsedURL.Path = unescaped
+			parsedURL.RawPath = b.rawPath
+			parsedURL.Opaque = ""
+		} else {
+			// Not valid percent-encoding; an opaque URL keeps the bytes as-is.
+			parsedURL.Opaque = b.rawPath
+		}
+	}
+	if b.fragment != "" {
+		if unescaped, err := url.PathUnescape(b.fragment); err == nil {
+			parsedURL.Fragment = unescaped
+			parsedURL.RawFragment = b.fragment
+		} else {
+			parsedURL.Fragment = b.fragment
+			parsedURL.RawFragment = ""
+		}
 	}
-	return preparedPath
 }
 
 // Queries adds query parameters to the request
@@ -130,17 +266,81 @@ func (b *RequestBuilder) Query(key, value string) *RequestBuilder {
 	return b
 }
 
-// DelQuery removes one or more query parameters from the request.
+// AddQuery adds a query parameter to the request, preserving any existing
+// values for the same key.
+func (b *RequestBuilder) AddQuery(key, value string) *RequestBuilder {
+	b.queries.Add(key, value)
+	return b
+}
+
+// SetQuery sets a query parameter on the request, replacing any existing
+// values for the same key.
+func (b *RequestBuilder) SetQuery(key, value string) *RequestBuilder {
+	b.queries.Set(key, value)
+	return b
+}
+
+// QueryInt adds an integer query parameter to the request.
+func (b *RequestBuilder) QueryInt(key string, value int) *RequestBuilder {
+	b.queries.Add(key, strconv.Itoa(value))
+	return b
+}
+
+// QueryBool adds a boolean query parameter to the request.
+func (b *RequestBuilder) QueryBool(key string, value bool) *RequestBuilder {
+	b.queries.Add(key, strconv.FormatBool(value))
+	return b
+}
+
+// QueryTime adds a time query parameter to the request, formatted with the given layout.
+func (b *RequestBuilder) QueryTime(key string, value time.Time, layout string) *RequestBuilder {
+	b.queries.Add(key, value.Format(layout))
+	return b
+}
+
+// QueryAny adds a query parameter to the request, encoding the value based on its type.
+// Slices add one parameter per element; times are formatted as RFC 3339; other
+// values fall back to their default string representation.
+func (b *RequestBuilder) QueryAny(key string, value any) *RequestBuilder {
+	switch v := value.(type) {
+	case string:
+		b.queries.Add(key, v)
+	case []string:
+		for _, item := range v {
+			b.queries.Add(key, item)
+		}
+	case bool:
+		b.queries.Add(key, strconv.FormatBool(v))
+	case time.Time:
+		b.queries.Add(key, v.Format(time.RFC3339))
+	case fmt.Stringer:
+		b.queries.Add(key, v.String())
+	default:
+		b.queries.Add(key, fmt.Sprintf("%v", v))
+	}
+	return b
+}
+
+// DelQuery removes one or more query parameters from the request, including
+// any client-level defaults for those keys.
 func (b *RequestBuilder) DelQuery(key ...string) *RequestBuilder {
 	for _, k := range key {
 		b.queries.Del(k)
 	}
+	b.delQueries = append(b.delQueries, key...)
 	return b
 }
 
-// QueriesStruct adds query parameters to the request based on a struct tagged with url tags.
+// QueriesStruct adds query parameters to the request based on a tagged
+// struct. The tag is "url" unless changed with SetQueriesStructTag; time
+// fields support the unix option. Encoding errors are accumulated on the
+// builder and surfaced by Validate, Build and Send.
 func (b *RequestBuilder) QueriesStruct(queryStruct interface{}) *RequestBuilder {
-	values, _ := query.Values(queryStruct) // Safely ignore error for simplicity
+	values, err := structQueryValues(queryStruct, b.client.getQueriesStructTag())
+	if err != nil {
+		b.addError(fmt.Errorf("%w: query struct: %v", ErrEncodingFailed, err)) //nolint:errorlint
+		return b
+	}
 	for key, value := range values {
 		for _, v := range value {
 			b.queries.Add(key, v)
@@ -179,6 +379,13 @@ func (b *RequestBuilder) DelHeader(key ...string) *RequestBuilder {
 	return b
 }
 
+// HostHeader overrides the Host header sent with the request, so a request
+// can target an IP or backend while presenting a different host name.
+func (b *RequestBuilder) HostHeader(host string) *RequestBuilder {
+	b.hostHeader = host
+	return b
+}
+
 // Cookies method for map
 func (b *RequestBuilder) Cookies(cookies map[string]string) *RequestBuilder {
 	for key, value := range cookies {
@@ -196,6 +403,19 @@ func (b *RequestBuilder) Cookie(key, value string) *RequestBuilder {
 	return b
 }
 
+// CookieFull adds a cookie to the request preserving all attributes
+// (Path, Domain, Expires, Secure, HttpOnly, SameSite, ...).
+func (b *RequestBuilder) CookieFull(cookie *http.Cookie) *RequestBuilder {
+	if cookie == nil {
+		return b
+	}
+	if b.cookies == nil {
+		b.cookies = []*http.Cookie{}
+	}
+	b.cookies = append(b.cookies, cookie)
+	return b
+}
+
 // DelCookie removes one or more cookies from the request.
 func (b *RequestBuilder) DelCookie(key ...string) *RequestBuilder {
 	if b.cookies != nil {
@@ -245,9 +465,7 @@ func (b *RequestBuilder) Form(v any) *RequestBuilder {
 	formFields, formFiles, err := parseForm(v)
 
 	if err != nil {
-		if b.client.Logger != nil {
-			b.client.Logger.Errorf("Error parsing form: %v", err)
-		}
+		b.addError(fmt.Errorf("form: %w", err))
 		return b
 	}
 
@@ -270,9 +488,7 @@ func (b *RequestBuilder) FormFields(fields any) *RequestBuilder {
 
 	values, err := parseFormFields(fields)
 	if err != nil {
-		if b.client.Logger != nil {
-			b.client.Logger.Errorf("Error parsing form fields: %v", err)
-		}
+		b.addError(fmt.Errorf("form fields: %w", err))
 		return b
 	}
 
@@ -327,6 +543,26 @@ func (b *RequestBuilder) File(key, filename string, content io.ReadCloser) *Requ
 	return b
 }
 
+// StringPart adds a multipart string part with an explicit content type.
+func (b *RequestBuilder) StringPart(key, value, contentType string) *RequestBuilder {
+	if b.formFiles == nil {
+		b.formFiles = []*File{}
+	}
+
+	b.formFiles = append(b.formFiles, &File{
+		Name:        key,
+		Content:     io.NopCloser(strings.NewReader(value)),
+		ContentType: contentType,
+	})
+	return b
+}
+
+// SetBoundary sets a custom boundary for multipart requests.
+func (b *RequestBuilder) SetBoundary(boundary string) *RequestBuilder {
+	b.boundary = boundary
+	return b
+}
+
 // DelFile removes one or more files from the request
 func (b *RequestBuilder) DelFile(key ...string) *RequestBuilder {
 	if b.formFiles != nil {
@@ -385,6 +621,92 @@ func (b *RequestBuilder) Timeout(timeout time.Duration) *RequestBuilder {
 	return b
 }
 
+// SkipMiddlewares excludes the named client middlewares (see UseNamed) from
+// this request only, so e.g. health checks can bypass the cache or a token
+// fetch can bypass auth without constructing a second client. Unknown names
+// and anonymous middlewares are ignored.
+func (b *RequestBuilder) SkipMiddlewares(names ...string) *RequestBuilder {
+	b.skipMiddlewares = append(b.skipMiddlewares, names...)
+	return b
+}
+
+// DeadlineBudget bounds the whole Send call — every retry attempt plus the
+// backoff between them — to d, guaranteeing Send returns within the budget.
+// When combined with Timeout, each attempt is additionally capped at the
+// per-attempt timeout, shrunk automatically as the budget runs down.
+func (b *RequestBuilder) DeadlineBudget(d time.Duration) *RequestBuilder {
+	b.deadlineBudget = d
+	return b
+}
+
+// Priority sets the scheduling priority used when the client bounds
+// concurrency via SetMaxConcurrency; higher values are dispatched first.
+func (b *RequestBuilder) Priority(priority int) *RequestBuilder {
+	b.priority = priority
+	return b
+}
+
+// FollowRedirects controls redirect following for this request only: false
+// returns the redirect response itself, true follows redirects even when the
+// client's CheckRedirect forbids them.
+func (b *RequestBuilder) FollowRedirects(follow bool) *RequestBuilder {
+	b.followRedirects = &follow
+	return b
+}
+
+// MaxRedirects caps how many redirects this request may follow before
+// failing with ErrTooManyRedirects.
+func (b *RequestBuilder) MaxRedirects(n int) *RequestBuilder {
+	b.maxRedirects = &n
+	return b
+}
+
+// redirectHTTPClient returns the HTTP client to use for this request,
+// shallow-cloning it when a per-request redirect policy is set.
+func (b *RequestBuilder) redirectHTTPClient() *http.Client {
+	httpClient := b.client.getHTTPClient()
+	if b.followRedirects == nil && b.maxRedirects == nil {
+		return httpClient
+	}
+
+	clone := *httpClient
+	switch {
+	case b.followRedirects != nil && !*b.followRedirects:
+		clone.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	case b.maxRedirects != nil:
+		maxRedirects := *b.maxRedirects
+		clone.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return fmt.Errorf("%w: stopped after %d redirects", ErrTooManyRedirects, maxRedirects)
+			}
+			return nil
+		}
+	default:
+		// FollowRedirects(true): restore the default follow behavior.
+		clone.CheckRedirect = nil
+	}
+	return &clone
+}
+
+// StrictDecode makes Scan fail when the response body contains fields not
+// present in the target struct, catching API contract drift early.
+func (b *RequestBuilder) StrictDecode() *RequestBuilder {
+	b.strictDecode = true
+	return b
+}
+
+// ExpectChecksum verifies the response body against a hex-encoded checksum
+// (md5, sha1, sha256, sha512, or crc32), failing the read with
+// ErrChecksumMismatch when the digest differs. It overrides any checksum
+// advertised by the response headers.
+func (b *RequestBuilder) ExpectChecksum(algo, hexSum string) *RequestBuilder {
+	b.checksumAlgo = algo
+	b.checksumHex = hexSum
+	return b
+}
+
 // MaxRetries sets the maximum number of retry attempts
 func (b *RequestBuilder) MaxRetries(maxRetries int) *RequestBuilder {
 	b.maxRetries = maxRetries
@@ -403,64 +725,185 @@ func (b *RequestBuilder) RetryIf(retryIf RetryIfFunc) *RequestBuilder {
 	return b
 }
 
-func (b *RequestBuilder) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+// OnRetry sets a hook invoked before each retry wait, overriding any
+// client-level hook for this request.
+func (b *RequestBuilder) OnRetry(hook RetryHook) *RequestBuilder {
+	b.onRetry = hook
+	return b
+}
+
+func (b *RequestBuilder) do(ctx context.Context, req *http.Request) (*http.Response, *retryStats, error) {
+	stats := &retryStats{}
+
 	finalHandler := MiddlewareHandlerFunc(func(req *http.Request) (*http.Response, error) {
-		var maxRetries = b.client.MaxRetries
+		maxRetries, retryStrategy, retryIf := b.client.getRetryConfig()
 		if b.maxRetries > 0 {
 			maxRetries = b.maxRetries
 		}
 
-		var retryStrategy = b.client.RetryStrategy
 		if b.retryStrategy != nil {
 			retryStrategy = b.retryStrategy
 		}
 
-		var retryIf = b.client.RetryIf
 		if b.retryIf != nil {
 			retryIf = b.retryIf
 		}
 
+		onRetry := b.client.getOnRetry()
+		if b.onRetry != nil {
+			onRetry = b.onRetry
+		}
+
+		httpClient := b.redirectHTTPClient()
+		challenge := b.client.getChallengeConfig()
+
+		// Per-attempt middlewares wrap the bare transport call and re-run on
+		// every attempt, with the attempt number on the context.
+		attemptHandler := MiddlewareHandlerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := httpClient.Do(b.attemptRequest(req))
+			if err != nil && b.client.isStaleConnectionError(err) && retryStaleConnection(req) {
+				// The pooled connection was already dead; flush idle
+				// connections and replay once on a fresh one.
+				if logger := b.client.getLogger(); logger != nil {
+					logger.Warnf("Stale connection detected, retrying on a fresh connection: %v", err)
+				}
+				b.client.CloseIdleConnections()
+				resp, err = httpClient.Do(b.attemptRequest(req))
+			}
+			if err != nil && b.client.http1FallbackEnabled() && isHTTP2NegotiationError(err) && retryStaleConnection(req) {
+				// The server does not speak h2; replay the attempt on the
+				// HTTP/1.1-only secondary transport.
+				if logger := b.client.getLogger(); logger != nil {
+					logger.Warnf("HTTP/2 negotiation failed, falling back to HTTP/1.1: %v", err)
+				}
+				resp, err = b.client.http1FallbackClient(httpClient).Do(b.attemptRequest(req))
+				if err == nil {
+					stats.http1Fallback = true
+				}
+			}
+			return resp, err
+		})
+		perAttempt := b.client.getPerAttemptMiddlewares()
+		for i := len(perAttempt) - 1; i >= 0; i-- {
+			attemptHandler = perAttempt[i](attemptHandler)
+		}
+		sendAttempt := func(req *http.Request, attempt int) (*http.Response, error) {
+			attemptStart := time.Now()
+			req = req.WithContext(WithAttempt(req.Context(), attempt))
+			// Dynamic headers are computed fresh for every attempt, so
+			// rotating tokens and signed timestamps stay valid on retries.
+			if err := b.applyHeaderProvider(req); err != nil {
+				stats.lastAttempt = time.Since(attemptStart)
+				return nil, err
+			}
+			resp, err := attemptHandler(req)
+			stats.lastAttempt = time.Since(attemptStart)
+			return resp, err
+		}
+
 		if maxRetries < 1 {
-			return b.client.HTTPClient.Do(req) // Single request, no retries
+			stats.attempts = 1
+			resp, err := sendAttempt(req, 1) // Single request, no retries
+			for replays := 0; err == nil && challenge != nil && replays < maxChallengeReplays; replays++ {
+				replay, challengeErr := challenge.handleChallenge(req, resp)
+				if challengeErr != nil {
+					return resp, challengeErr
+				}
+				if !replay {
+					break
+				}
+				b.client.drainAndClose(resp.Body)
+				if req.GetBody != nil {
+					newBody, bodyErr := req.GetBody()
+					if bodyErr != nil {
+						return resp, bodyErr
+					}
+					req.Body = newBody
+				}
+				stats.attempts++
+				resp, err = sendAttempt(req, stats.attempts)
+			}
+			return resp, err
 		}
 
 		var lastErr error
 		var resp *http.Response
+		challengeReplays := 0
 		for attempt := 0; attempt <= maxRetries; attempt++ {
-			resp, lastErr = b.client.HTTPClient.Do(req)
+			// Rewind the body so retry attempts resend it from the start.
+			if attempt > 0 && req.GetBody != nil {
+				newBody, err := req.GetBody()
+				if err != nil {
+					if logger := b.client.getLogger(); logger != nil {
+						logger.Errorf("Error rewinding request body: %v", err)
+					}
+					return resp, err
+				}
+				req.Body = newBody
+			}
+
+			resp, lastErr = sendAttempt(req, attempt+1)
+			stats.attempts = attempt + 1
+
+			// Let the challenge handler inspect matching responses and ask
+			// for a replay, bounded independently of the retry budget.
+			challengeReplay := false
+			if lastErr == nil && challenge != nil && challengeReplays < maxChallengeReplays {
+				replay, challengeErr := challenge.handleChallenge(req, resp)
+				if challengeErr != nil {
+					return resp, challengeErr
+				}
+				if replay {
+					challengeReplays++
+					challengeReplay = true
+				}
+			}
 
 			// Determine if a retry is needed
-			shouldRetry := lastErr != nil || (resp != nil && retryIf != nil && retryIf(req, resp, lastErr))
+			shouldRetry := challengeReplay || lastErr != nil || (resp != nil && retryIf != nil && retryIf(req, resp, lastErr))
 			if !shouldRetry || attempt == maxRetries {
 				if lastErr != nil {
-					if b.client.Logger != nil {
-						b.client.Logger.Errorf("Error after %d attempts: %v", attempt+1, lastErr)
+					if logger := b.client.getLogger(); logger != nil {
+						logger.Errorf("Error after %d attempts: %v", attempt+1, lastErr)
 					}
 				}
 				break
 			}
 
 			if resp != nil {
-				if err := resp.Body.Close(); err != nil {
-					if b.client.Logger != nil {
-						b.client.Logger.Errorf("Error closing response body: %v", err)
-					}
-				}
+				// Drain before closing so the keep-alive connection can
+				// serve the retry instead of forcing a fresh dial.
+				b.client.drainAndClose(resp.Body)
 			}
 
 			// Logging retry decision
-			if b.client.Logger != nil {
-				b.client.Logger.Infof("Retrying request (attempt %d) after backoff", attempt+1)
+			if logger := b.client.getLogger(); logger != nil {
+				logger.Infof("Retrying request (attempt %d) after backoff", attempt+1)
+			}
+
+			// Notify the retry hook before backing off.
+			if onRetry != nil {
+				onRetry(attempt+1, req, resp, lastErr)
+			}
+
+			delay := retryStrategy(attempt)
+			if hook := b.client.getBackoffFromResponse(); hook != nil && resp != nil {
+				delay = hook(resp, delay)
+			}
+			stats.delayTotal += delay
+
+			if slogCfg := b.client.getSlogConfig(); slogCfg != nil {
+				slogCfg.logRetry(req.Context(), req.Method, b.client.redactURLForLog(req.URL), attempt+1, delay)
 			}
 
 			// Logging context cancellation as an error condition
 			select {
 			case <-ctx.Done():
-				if b.client.Logger != nil {
-					b.client.Logger.Errorf("Request canceled or timed out: %v", ctx.Err())
+				if logger := b.client.getLogger(); logger != nil {
+					logger.Errorf("Request canceled or timed out: %v", ctx.Err())
 				}
 				return nil, ctx.Err()
-			case <-time.After(retryStrategy(attempt)):
+			case <-time.After(delay):
 				// Backoff before retrying
 			}
 		}
@@ -468,19 +911,37 @@ func (b *RequestBuilder) do(ctx context.Context, req *http.Request) (*http.Respo
 		return resp, lastErr
 	})
 
-	if b.middlewares != nil {
-		for i := len(b.middlewares) - 1; i >= 0; i-- {
-			finalHandler = b.middlewares[i](finalHandler)
+	// Mark errors from below the chain so they are not attributed to a middleware.
+	transportHandler := finalHandler
+	finalHandler = func(req *http.Request) (*http.Response, error) {
+		resp, err := transportHandler(req)
+		if err != nil {
+			err = &transportError{err: err}
 		}
+		return resp, err
 	}
 
-	if b.client.Middlewares != nil {
-		for i := len(b.client.Middlewares) - 1; i >= 0; i-- {
-			finalHandler = b.client.Middlewares[i](finalHandler)
+	clientMiddlewares, clientNames := b.client.getMiddlewareEntries()
+	skip := make(map[string]bool, len(b.skipMiddlewares))
+	for _, name := range b.skipMiddlewares {
+		skip[name] = true
+	}
+
+	for i := len(b.middlewares) - 1; i >= 0; i-- {
+		finalHandler = attributeMiddlewareError("", len(clientMiddlewares)+i, b.middlewares[i](finalHandler))
+	}
+	for i := len(clientMiddlewares) - 1; i >= 0; i-- {
+		if clientNames[i] != "" && skip[clientNames[i]] {
+			continue
 		}
+		finalHandler = attributeMiddlewareError(clientNames[i], i, clientMiddlewares[i](finalHandler))
 	}
 
-	return finalHandler(req)
+	resp, err := finalHandler(req)
+	if unwrapped, ok := err.(*transportError); ok { //nolint:errorlint // Top-level marker only.
+		err = unwrapped.err
+	}
+	return resp, stats, err
 }
 
 // Stream sets the stream callback for the request
@@ -501,13 +962,53 @@ func (b *RequestBuilder) StreamDone(callback StreamDoneCallback) *RequestBuilder
 	return b
 }
 
-// Send executes the HTTP request.
-func (b *RequestBuilder) Send(ctx context.Context) (*Response, error) {
+// StreamIdleTimeout sets the maximum time to wait between stream chunks.
+// When no data arrives within the timeout, the stream ends with ErrStreamIdleTimeout.
+func (b *RequestBuilder) StreamIdleTimeout(timeout time.Duration) *RequestBuilder {
+	b.streamIdleTimeout = timeout
+	return b
+}
+
+// StreamChan configures channel-based streaming as an alternative to
+// callbacks. Chunks are delivered on the data channel, which is closed when
+// the stream ends; a terminal error, if any, is sent on the error channel
+// before it is closed.
+func (b *RequestBuilder) StreamChan() (<-chan []byte, <-chan error) {
+	dataCh := make(chan []byte)
+	errCh := make(chan error, 1)
+
+	b.stream = func(data []byte) error {
+		// Copy the chunk; the underlying buffer is reused between reads.
+		chunk := make([]byte, len(data))
+		copy(chunk, data)
+		dataCh <- chunk
+		return nil
+	}
+	b.streamErr = func(err error) {
+		errCh <- err
+	}
+	b.streamDone = func() {
+		close(dataCh)
+		close(errCh)
+	}
+
+	return dataCh, errCh
+}
+
+// prepareBodyAndURL encodes the request body and assembles the full URL,
+// merging query parameters from the URL, the client defaults, and the Query
+// method. Keys set on the builder replace inherited values, but repeated
+// builder values for the same key are all preserved.
+func (b *RequestBuilder) prepareBodyAndURL() (io.Reader, *url.URL, error) {
 	var body io.Reader
 	var contentType string
 	var err error
 
 	switch {
+	case len(b.relatedParts) > 0:
+		// Explicit related parts take the multipart/related encoding.
+		body, contentType, err = b.prepareRelatedBody()
+
 	case len(b.formFiles) > 0:
 		// If the request includes files, indicating multipart/form-data encoding is required.
 		body, contentType, err = b.prepareMultipartBody()
@@ -522,10 +1023,10 @@ func (b *RequestBuilder) Send(ctx context.Context) (*Response, error) {
 	}
 
 	if err != nil {
-		if b.client.Logger != nil {
-			b.client.Logger.Errorf("Error preparing request body: %v", err)
+		if logger := b.client.getLogger(); logger != nil {
+			logger.Errorf("Error preparing request body: %v", err)
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	if contentType != "" {
@@ -533,52 +1034,95 @@ func (b *RequestBuilder) Send(ctx context.Context) (*Response, error) {
 		b.headers.Set("Content-Type", contentType)
 	}
 
-	// Parse the complete URL first to handle any modifications needed.
-	parsedURL, err := url.Parse(b.client.BaseURL + b.preparePath())
+	// Expand path params before assembling the URL.
+	preparedPath, err := b.preparePath()
 	if err != nil {
-		if b.client.Logger != nil {
-			b.client.Logger.Errorf("Error parsing URL: %v", err)
+		if logger := b.client.getLogger(); logger != nil {
+			logger.Errorf("Error preparing path: %v", err)
 		}
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Assemble the complete URL from the base URL and the prepared path.
+	parsedURL, err := b.prepareURL(preparedPath)
+	if err != nil {
+		if logger := b.client.getLogger(); logger != nil {
+			logger.Errorf("Error parsing URL: %v", err)
+		}
+		return nil, nil, err
 	}
 
-	// Combine query parameters from both the URL and the Query method.
 	query := parsedURL.Query()
+	for key, values := range b.client.getQueries() {
+		for _, value := range values {
+			query.Add(key, value)
+		}
+	}
+	for _, key := range b.delQueries {
+		query.Del(key)
+	}
 	for key, values := range b.queries {
+		query.Del(key)
 		for _, value := range values {
-			query.Set(key, value) // Add new values, preserving existing ones.
+			query.Add(key, value)
 		}
 	}
-	parsedURL.RawQuery = query.Encode()
+	parsedURL.RawQuery = b.client.encodeQuery(query)
 
-	// Create a context with a timeout if one is not already set.
-	var cancel context.CancelFunc
-	if _, ok := ctx.Deadline(); !ok {
-		if b.timeout > 0 {
-			ctx, cancel = context.WithTimeout(ctx, b.timeout)
-			defer cancel()
+	// Apply the exact-byte fragment and raw path overrides last, so query
+	// and path assembly cannot re-escape them.
+	b.applyRawURLParts(parsedURL)
+
+	return body, parsedURL, nil
+}
+
+// assembleRequest creates the *http.Request and applies the host override,
+// auth, headers, header profile, and cookies.
+func (b *RequestBuilder) assembleRequest(ctx context.Context, parsedURL *url.URL, body io.Reader) (*http.Request, error) {
+	requestURL := parsedURL.String()
+	if b.rawPath != "" || b.fragment != "" {
+		// Opaque raw paths may not survive a String/Parse round trip; create
+		// the request against a minimal URL and re-apply the exact one below.
+		if _, parseErr := url.Parse(requestURL); parseErr != nil {
+			requestURL = (&url.URL{Scheme: parsedURL.Scheme, Host: parsedURL.Host}).String()
 		}
 	}
-
-	// Create the HTTP request with the fully prepared URL, including query parameters.
-	req, err := http.NewRequestWithContext(ctx, b.method, parsedURL.String(), body)
+	req, err := http.NewRequestWithContext(ctx, b.method, requestURL, body)
 	if err != nil {
-		if b.client.Logger != nil {
-			b.client.Logger.Errorf("Error creating request: %v", err)
+		if logger := b.client.getLogger(); logger != nil {
+			logger.Errorf("Error creating request: %v", err)
 		}
 		return nil, fmt.Errorf("%w: %v", ErrRequestCreationFailed, err) //nolint:errorlint
 	}
+	if b.rawPath != "" || b.fragment != "" {
+		// Bypass the re-parse so the exact-byte URL parts reach the request.
+		req.URL = parsedURL
+	}
+
+	// Make the body rewindable so retries can resend it from the start.
+	if err := makeBodyRewindable(req, body); err != nil {
+		if logger := b.client.getLogger(); logger != nil {
+			logger.Errorf("Error buffering request body: %v", err)
+		}
+		return nil, err
+	}
+
+	// Override the Host header when requested.
+	if b.hostHeader != "" {
+		req.Host = b.hostHeader
+	}
 
 	if b.auth != nil {
 		b.auth.Apply(req)
-	} else if b.client.auth != nil {
-		b.client.auth.Apply(req)
+	} else if hostAuth := b.client.getHostAuth(req.URL); hostAuth != nil {
+		hostAuth.Apply(req)
+	} else if clientAuth := b.client.getAuth(); clientAuth != nil {
+		clientAuth.Apply(req)
 	}
 
 	// Set the headers from the client and the request builder.
-	if b.client.Headers != nil {
-		for key := range *b.client.Headers {
-			values := (*b.client.Headers)[key]
+	if clientHeaders := b.client.getHeaders(); clientHeaders != nil {
+		for key, values := range clientHeaders {
 			for _, value := range values {
 				req.Header.Add(key, value)
 			}
@@ -592,43 +1136,319 @@ func (b *RequestBuilder) Send(ctx context.Context) (*Response, error) {
 			}
 		}
 	}
+	b.client.applyProfileHeaders(req.Header)
+	b.client.applyDefaultUserAgent(req.Header)
+	b.applyIdempotencyKey(req.Header)
+
+	// A client-default Content-Type without a body is misleading; drop it.
+	// One set explicitly on the builder is kept — the caller asked for it.
+	if body == nil && b.headers.Get("Content-Type") == "" {
+		req.Header.Del("Content-Type")
+	}
 
 	// Merge cookies from the client and the request builder.
-	if b.client.Cookies != nil {
-		for _, cookie := range b.client.Cookies {
-			req.AddCookie(cookie)
-		}
+	for _, cookie := range b.client.getCookies() {
+		addRequestCookie(req, cookie)
 	}
 	if b.cookies != nil {
 		for _, cookie := range b.cookies {
-			req.AddCookie(cookie)
+			addRequestCookie(req, cookie)
+		}
+	}
+
+	return req, nil
+}
+
+// addRequestCookie attaches a cookie to the request, honoring the Secure and
+// Expires attributes: secure cookies are withheld from non-HTTPS requests and
+// expired cookies are not sent at all.
+func addRequestCookie(req *http.Request, cookie *http.Cookie) {
+	if cookie.Secure && req.URL.Scheme != "https" {
+		return
+	}
+	if !cookie.Expires.IsZero() && cookie.Expires.Before(time.Now()) {
+		return
+	}
+	req.AddCookie(cookie)
+}
+
+// Build performs all request preparation — URL assembly, body encoding,
+// headers, auth — and returns the prepared *http.Request without sending it,
+// so tests and signing workflows can inspect the request or hand it to
+// another transport. Middlewares do not run; they only wrap Send.
+func (b *RequestBuilder) Build(ctx context.Context) (*http.Request, error) {
+	if b.client.isClosed() {
+		return nil, ErrClientClosed
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, parsedURL, err := b.prepareBodyAndURL()
+	if err != nil {
+		return nil, err
+	}
+	return b.assembleRequest(b.withTags(ctx), parsedURL, body)
+}
+
+// Send executes the HTTP request, failing over across the client's mirror
+// base URLs when a pool is configured via SetBaseURLs.
+func (b *RequestBuilder) Send(ctx context.Context) (*Response, error) {
+	if pool := b.client.getBaseURLPool(); pool != nil {
+		return b.sendWithFailover(ctx, pool)
+	}
+	return b.sendOnce(ctx)
+}
+
+// sendOnce executes the HTTP request against a single base URL.
+func (b *RequestBuilder) sendOnce(ctx context.Context) (*Response, error) {
+	if b.client.isClosed() {
+		return nil, ErrClientClosed
+	}
+
+	// Reject conflicting or broken configuration before doing any work.
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Tags ride the context so middleware, hooks, logs and error artifacts
+	// can attribute the call without touching the wire request.
+	ctx = b.withTags(ctx)
+
+	// Record the auth generation this request starts under, so a later 401
+	// can tell whether credentials were already refreshed concurrently.
+	reauthGeneration := 0
+	if reauth := b.client.getReauthManager(); reauth != nil {
+		reauthGeneration = reauth.currentGeneration()
+	}
+
+	body, parsedURL, err := b.prepareBodyAndURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a context with a timeout if one is not already set. A deadline
+	// budget always applies, so retries and backoff cannot exceed it; the
+	// per-attempt timeout is then enforced by attemptRequest instead.
+	var cancel context.CancelFunc
+	if b.deadlineBudget > 0 {
+		ctx, cancel = context.WithTimeout(ctx, b.deadlineBudget)
+		defer cancel()
+		defer b.releaseAttemptContexts()
+	} else if _, ok := ctx.Deadline(); !ok {
+		if b.timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, b.timeout)
+			defer cancel()
+		}
+	}
+
+	// Wait for a scheduler slot when the client bounds concurrency.
+	if sched := b.client.getScheduler(); sched != nil {
+		if err := sched.acquire(ctx, b.priority); err != nil {
+			return nil, err
 		}
+		defer sched.release()
+	}
+
+	// Track connection pool activity for this request.
+	if b.client.metrics != nil {
+		ctx = b.client.metrics.requestStarted(ctx, parsedURL.Host)
+		defer b.client.metrics.requestFinished(parsedURL.Host)
+	}
+
+	// Record whether the TLS session for this call was resumed and track the
+	// transport phase reached, so failures can be classified per phase.
+	var tlsResumed atomic.Bool
+	tracker := &phaseTracker{}
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			tracker.enter(phaseDNS)
+		},
+		ConnectStart: func(string, string) {
+			tracker.enter(phaseConnect)
+		},
+		TLSHandshakeStart: func() {
+			tracker.enter(phaseTLSHandshake)
+		},
+		TLSHandshakeDone: func(state tls.ConnectionState, _ error) {
+			tlsResumed.Store(state.DidResume)
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			tracker.enter(phaseAwaitingHeaders)
+		},
+		GotFirstResponseByte: func() {
+			tracker.enter(phaseBody)
+		},
+	})
+
+	// Create the HTTP request with the fully prepared URL, headers, and auth.
+	req, err := b.assembleRequest(ctx, parsedURL, body)
+	if err != nil {
+		return nil, err
 	}
 
+	// Connect to a cached alternative service for this origin, if any.
+	b.client.applyAltSvc(req)
+
 	// Execute the HTTP request.
-	resp, err := b.do(ctx, req)
+	requestStart := time.Now()
+	resp, stats, err := b.do(ctx, req)
+	if slogCfg := b.client.getSlogConfig(); slogCfg != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		slogCfg.logRequest(ctx, b.method, b.client.redactURLForLog(req.URL), statusCode, stats.attempts, time.Since(requestStart), err)
+	}
 	if err != nil {
-		if b.client.Logger != nil {
-			b.client.Logger.Errorf("Error executing request: %v", err)
+		if logger := b.client.getLogger(); logger != nil {
+			logger.Errorf("Error executing request: %v", err)
 		}
 
+		statusCode := 0
+		var respHeader http.Header
 		if resp != nil {
+			statusCode = resp.StatusCode
+			respHeader = resp.Header
 			_ = resp.Body.Close()
 		}
 
+		err = classifyTransportError(tracker.current(), err)
+		b.client.writeErrorArtifact(req, statusCode, respHeader, nil, stats.attempts, stats.delayTotal, time.Since(requestStart), err)
+		b.client.writeAudit(req.URL, b.method, statusCode, stats.attempts, time.Since(requestStart), 0, err, b.tags)
 		return nil, err
 	}
 
 	if resp == nil {
-		if b.client.Logger != nil {
-			b.client.Logger.Errorf("Response is nil")
+		if logger := b.client.getLogger(); logger != nil {
+			logger.Errorf("Response is nil")
 		}
 
 		return nil, fmt.Errorf("%w: %v", ErrResponseNil, err) //nolint:errorlint
 	}
 
-	// Wrap and return the response.
-	return NewResponse(ctx, resp, b.client, b.stream, b.streamErr, b.streamDone)
+	// Verify the body against an expected or advertised checksum as it is read.
+	if err := b.attachChecksum(resp); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	// Wrap and return the response, attaching metadata before body handling starts.
+	response := newResponse(ctx, resp, b.client, b.stream, b.streamErr, b.streamDone)
+	response.attempts = stats.attempts
+	response.retryDelayTotal = stats.delayTotal
+	response.streamIdleTimeout = b.streamIdleTimeout
+	response.streamResume = b.streamResume
+	response.streamOffset = b.streamResumeFrom
+	response.streamResumesLeft = b.streamResumesLeft
+	response.tlsResumed = tlsResumed.Load()
+	response.usedHTTP1Fallback = stats.http1Fallback
+	response.totalDuration = time.Since(requestStart)
+	response.lastAttemptDuration = stats.lastAttempt
+
+	// Remember alternative services this origin advertises for later calls.
+	b.client.recordAltSvc(parsedURL, response.Header())
+	response.strictDecode = b.strictDecode
+	response.unbuffered = b.unbuffered
+
+	if err := response.start(); err != nil {
+		err = classifyTransportError(tracker.current(), err)
+		b.client.writeAudit(req.URL, b.method, response.StatusCode(), stats.attempts, time.Since(requestStart), 0, err, b.tags)
+		return nil, err
+	}
+
+	// One audit line per completed call, once the body size is known.
+	auditBytes := int64(len(response.BodyBytes))
+	if auditBytes == 0 && resp.ContentLength > 0 {
+		auditBytes = resp.ContentLength
+	}
+	b.client.writeAudit(req.URL, b.method, response.StatusCode(), stats.attempts, response.totalDuration, auditBytes, nil, b.tags)
+
+	// Refresh credentials and retry once on authentication failures. The
+	// manager serializes refreshes, so a 401 observed under an already
+	// superseded auth generation reuses the refreshed credentials instead
+	// of invoking the handler again.
+	if reauth := b.client.getReauthManager(); reauth != nil && !b.reauthAttempted && isAuthFailure(response.StatusCode()) {
+		b.reauthAttempted = true
+		if refreshErr := reauth.refresh(ctx, response, b.client, reauthGeneration); refreshErr != nil {
+			return response, refreshErr
+		}
+		if auth := reauth.currentAuth(); auth != nil {
+			b.auth = auth
+		}
+		_ = response.Close()
+		return b.Send(ctx)
+	}
+
+	// Run the response interceptor chain on the wrapped response.
+	for _, interceptor := range b.client.getResponseInterceptors() {
+		response, err = interceptor(response)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Enforce the content negotiation recorded by Negotiate, if any.
+	if negotiateErr := b.verifyNegotiation(response); negotiateErr != nil {
+		return response, negotiateErr
+	}
+
+	// Surface problem details bodies as typed errors when configured.
+	if b.client.shouldFailOnProblem() && response.IsProblem() {
+		problem, problemErr := response.ProblemDetails()
+		if problemErr != nil {
+			return response, problemErr
+		}
+		b.client.writeErrorArtifact(req, response.StatusCode(), response.Header(), response.Body(), response.attempts, response.retryDelayTotal, time.Since(requestStart), problem)
+		return response, problem
+	}
+
+	return response, nil
+}
+
+// makeBodyRewindable ensures req.GetBody is set so each retry attempt can
+// resend a complete body. Bodies backed by files are re-opened on rewind;
+// other reader types http.NewRequest does not recognize are buffered in memory.
+func makeBodyRewindable(req *http.Request, body io.Reader) error {
+	if body == nil || req.GetBody != nil {
+		return nil
+	}
+
+	// Re-open file-backed bodies instead of buffering large files in memory.
+	// With a known ContentLength the transport can skip chunked encoding and
+	// use sendfile-style zero-copy writes on supporting connections.
+	if file, ok := body.(*os.File); ok {
+		if info, statErr := file.Stat(); statErr == nil && info.Mode().IsRegular() {
+			length := info.Size()
+			if offset, seekErr := file.Seek(0, io.SeekCurrent); seekErr == nil && offset > 0 {
+				length -= offset
+			}
+			if length >= 0 {
+				req.ContentLength = length
+			}
+		}
+		name := file.Name()
+		req.GetBody = func() (io.ReadCloser, error) {
+			return os.Open(name) //nolint:gosec // Re-opening the caller-provided file path.
+		}
+		return nil
+	}
+
+	// Buffer the body so it can be replayed on each attempt.
+	buffered, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("buffering request body failed: %w", err)
+	}
+	if closer, ok := body.(io.Closer); ok {
+		_ = closer.Close()
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(buffered))
+	req.ContentLength = int64(len(buffered))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buffered)), nil
+	}
+	return nil
 }
 
 func (b *RequestBuilder) prepareMultipartBody() (io.Reader, string, error) {
@@ -653,8 +1473,8 @@ func (b *RequestBuilder) prepareMultipartBody() (io.Reader, string, error) {
 
 	// add form files
 	for _, file := range b.formFiles {
-		// create a new multipart part for the file
-		part, err := writer.CreateFormFile(file.Name, file.FileName)
+		// create a new multipart part honoring per-part content type and headers
+		part, err := writer.CreatePart(filePartHeader(file))
 		if err != nil {
 			return nil, "", fmt.Errorf("creating form file failed: %w", err)
 		}
@@ -679,9 +1499,37 @@ func (b *RequestBuilder) prepareMultipartBody() (io.Reader, string, error) {
 	return &buf, writer.FormDataContentType(), nil
 }
 
+// quoteEscaper escapes quotes and backslashes in multipart header values, as
+// mime/multipart does internally.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// filePartHeader builds the MIME header for a multipart part, merging any
+// custom headers with the content disposition and per-part content type.
+func filePartHeader(file *File) textproto.MIMEHeader {
+	header := textproto.MIMEHeader{}
+	for key, values := range file.Header {
+		header[key] = values
+	}
+
+	disposition := fmt.Sprintf(`form-data; name="%s"`, quoteEscaper.Replace(file.Name))
+	if file.FileName != "" {
+		disposition += fmt.Sprintf(`; filename="%s"`, quoteEscaper.Replace(file.FileName))
+	}
+	header.Set("Content-Disposition", disposition)
+
+	switch {
+	case file.ContentType != "":
+		header.Set("Content-Type", file.ContentType)
+	case file.FileName != "" && header.Get("Content-Type") == "":
+		header.Set("Content-Type", "application/octet-stream")
+	}
+
+	return header
+}
+
 func (b *RequestBuilder) prepareFormFieldsBody() (io.Reader, string) {
-	// Encode formFields as URL-encoded string
-	data := b.formFields.Encode()
+	// Encode formFields as URL-encoded string, under the client's policy.
+	data := b.client.encodeQuery(b.formFields)
 	return strings.NewReader(data), "application/x-www-form-urlencoded"
 }
 
@@ -689,14 +1537,35 @@ func (b *RequestBuilder) prepareBodyBasedOnContentType() (io.Reader, string, err
 	// Determine and prepare the body based on the specific Content-Type
 	contentType := b.headers.Get("Content-Type")
 
+	// File-backed bodies bypass encoding entirely and are handed straight to
+	// the transport, so large uploads are never buffered in memory.
+	if file, ok := b.bodyData.(*os.File); ok {
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		return file, contentType, nil
+	}
+
 	if contentType == "" && b.bodyData != nil {
-		switch b.bodyData.(type) {
-		case url.Values, map[string][]string, map[string]string:
-			contentType = "application/x-www-form-urlencoded"
-		case map[string]interface{}, []interface{}, struct{}:
-			contentType = "application/json"
-		case string, []byte:
-			contentType = "text/plain"
+		if infer := b.client.getContentTypeInferrer(); infer != nil {
+			contentType = infer(b.bodyData)
+		}
+
+		if contentType == "" {
+			switch b.bodyData.(type) {
+			case url.Values, map[string][]string, map[string]string:
+				contentType = "application/x-www-form-urlencoded"
+			case map[string]interface{}, []interface{}, struct{}:
+				contentType = "application/json"
+			case string, []byte:
+				contentType = "text/plain"
+			default:
+				// Arbitrary structs and pointers to structs default to JSON.
+				kind := reflect.Indirect(reflect.ValueOf(b.bodyData)).Kind()
+				if kind == reflect.Struct || kind == reflect.Map || kind == reflect.Slice {
+					contentType = "application/json"
+				}
+			}
 		}
 
 		// Set the inferred Content-Type
@@ -707,12 +1576,15 @@ func (b *RequestBuilder) prepareBodyBasedOnContentType() (io.Reader, string, err
 	var err error
 
 	switch contentType {
-	case "application/json":
-		body, err = b.client.JSONEncoder.Encode(b.bodyData)
+	case "application/json", jsonPatchContentType, mergePatchContentType:
+		body, err = b.client.getJSONEncoder().Encode(b.bodyData)
+		if err == nil && b.client.useCanonicalJSON() {
+			body, err = canonicalizeJSONReader(body)
+		}
 	case "application/xml":
-		body, err = b.client.XMLEncoder.Encode(b.bodyData)
+		body, err = b.client.getXMLEncoder().Encode(b.bodyData)
 	case "application/yaml":
-		body, err = b.client.YAMLEncoder.Encode(b.bodyData)
+		body, err = b.client.getYAMLEncoder().Encode(b.bodyData)
 	case "application/x-www-form-urlencoded":
 		body, err = DefaultFormEncoder.Encode(b.bodyData)
 	case "text/plain", "application/octet-stream":
@@ -722,10 +1594,21 @@ func (b *RequestBuilder) prepareBodyBasedOnContentType() (io.Reader, string, err
 		case []byte:
 			body = bytes.NewReader(data)
 		default:
-			err = fmt.Errorf("%w: %s", ErrUnsupportedContentType, contentType)
+			err = fmt.Errorf("%w: %q for body of type %T", ErrUnsupportedContentType, contentType, b.bodyData)
 		}
 	default:
-		err = fmt.Errorf("%w: %s", ErrUnsupportedContentType, contentType)
+		// Pass raw payloads through for content types without a dedicated
+		// encoder, e.g. multipart/mixed batch bodies.
+		switch data := b.bodyData.(type) {
+		case string:
+			body = strings.NewReader(data)
+		case []byte:
+			body = bytes.NewReader(data)
+		case io.Reader:
+			body = data
+		default:
+			err = fmt.Errorf("%w: %q for body of type %T", ErrUnsupportedContentType, contentType, b.bodyData)
+		}
 	}
 
 	return body, contentType, err
@@ -11,6 +11,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -863,3 +865,424 @@ func TestAuthRequest(t *testing.T) {
 		t.Errorf("Expected status code 200, got %d. Indicates Authorization header was not set correctly.", resp.StatusCode())
 	}
 }
+
+func TestQueryMultiValuesPreserved(t *testing.T) {
+	// Start a test HTTP server that echoes back the raw query string received.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	// Repeated keys added via Query must all reach the server.
+	resp, err := client.Get("/test").
+		Query("tag", "go").
+		Query("tag", "http").
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, "tag=go&tag=http", resp.String())
+}
+
+func TestTypedQuerySetters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	when := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	resp, err := client.Get("/test").
+		QueryInt("page", 3).
+		QueryBool("active", true).
+		QueryTime("since", when, time.RFC3339).
+		QueryAny("score", 4.5).
+		QueryAny("tags", []string{"a", "b"}).
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	values, err := url.ParseQuery(resp.String())
+	assert.NoError(t, err)
+	assert.Equal(t, "3", values.Get("page"))
+	assert.Equal(t, "true", values.Get("active"))
+	assert.Equal(t, "2024-05-01T12:00:00Z", values.Get("since"))
+	assert.Equal(t, "4.5", values.Get("score"))
+	assert.Equal(t, []string{"a", "b"}, values["tags"])
+}
+
+func TestSetQueryReplacesValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	// AddQuery accumulates values while SetQuery replaces them.
+	resp, err := client.Get("/test").
+		AddQuery("mode", "a").
+		AddQuery("mode", "b").
+		SetQuery("mode", "final").
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, "mode=final", resp.String())
+}
+
+func TestBaseURLJoining(t *testing.T) {
+	// Echo back the path the server received.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.Path)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		baseURL  string
+		path     string
+		expected string
+	}{
+		{
+			name:     "trailing slash on base",
+			baseURL:  server.URL + "/",
+			path:     "/users",
+			expected: "/users",
+		},
+		{
+			name:     "base with path prefix",
+			baseURL:  server.URL + "/api/v1",
+			path:     "/users",
+			expected: "/api/v1/users",
+		},
+		{
+			name:     "base with path prefix and trailing slash",
+			baseURL:  server.URL + "/api/v1/",
+			path:     "users",
+			expected: "/api/v1/users",
+		},
+		{
+			name:     "no slashes at boundary",
+			baseURL:  server.URL,
+			path:     "users",
+			expected: "/users",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := Create(&Config{BaseURL: tc.baseURL})
+			resp, err := client.Get(tc.path).Send(context.Background())
+			if err != nil {
+				t.Fatalf("Failed to send request: %v", err)
+			}
+			defer resp.Close() //nolint: errcheck
+
+			assert.Equal(t, tc.expected, resp.String())
+		})
+	}
+}
+
+func TestAbsoluteURLOverridesBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.Path)
+	}))
+	defer server.Close()
+
+	// The client points at a host that does not exist; the absolute URL must win.
+	client := Create(&Config{BaseURL: "http://192.0.2.1"})
+
+	resp, err := client.Get(server.URL + "/absolute").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, "/absolute", resp.String())
+}
+
+func TestRetryResendsRequestBody(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"Retry"}` {
+			t.Errorf("attempt %d received body %q", atomic.LoadInt32(&attempts), string(body))
+		}
+
+		// Fail the first two attempts so the client retries.
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL, MaxRetries: 3})
+
+	resp, err := client.Post("/").
+		JSONBody(map[string]string{"name": "Retry"}).
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestOnRetryHookAndAttemptMetadata(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{
+		BaseURL:       server.URL,
+		MaxRetries:    3,
+		RetryStrategy: DefaultBackoffStrategy(10 * time.Millisecond),
+	})
+
+	var hookAttempts []int
+	resp, err := client.Get("/").
+		OnRetry(func(attempt int, req *http.Request, resp *http.Response, err error) {
+			hookAttempts = append(hookAttempts, attempt)
+		}).
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	// Two failed attempts trigger the hook twice before the third succeeds.
+	assert.Equal(t, []int{1, 2}, hookAttempts)
+	assert.Equal(t, 3, resp.Attempts())
+	assert.GreaterOrEqual(t, resp.RetryDelayTotal(), 20*time.Millisecond)
+}
+
+func TestHostHeaderOverride(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Host)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").
+		HostHeader("api.internal.example").
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+
+	assert.Equal(t, "api.internal.example", resp.String())
+}
+
+func TestBodyContentTypeInference(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Received-Content-Type", r.Header.Get("Content-Type"))
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	// Arbitrary structs default to JSON without an explicit header.
+	type payload struct {
+		Name string `json:"name"`
+	}
+	resp, err := client.Post("/").Body(payload{Name: "widget"}).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Contains(t, resp.Header().Get("X-Received-Content-Type"), "application/json")
+	assert.Equal(t, `{"name":"widget"}`, resp.String())
+
+	// Pointers to structs are treated the same way.
+	resp, err = client.Post("/").Body(&payload{Name: "widget"}).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Contains(t, resp.Header().Get("X-Received-Content-Type"), "application/json")
+}
+
+func TestSetContentTypeInferrer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Received-Content-Type", r.Header.Get("Content-Type"))
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetContentTypeInferrer(func(body any) string {
+		if _, ok := body.(string); ok {
+			return "application/octet-stream"
+		}
+		return ""
+	})
+
+	resp, err := client.Post("/").Body("raw payload").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "application/octet-stream", resp.Header().Get("X-Received-Content-Type"))
+
+	// Returning "" falls back to the built-in inference.
+	resp, err = client.Post("/").Body(map[string]string{"key": "value"}).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "application/x-www-form-urlencoded", resp.Header().Get("X-Received-Content-Type"))
+}
+
+func TestPerRequestRedirectControls(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/hop1":
+			http.Redirect(w, r, "/hop2", http.StatusFound)
+		case "/hop2":
+			http.Redirect(w, r, "/final", http.StatusFound)
+		default:
+			fmt.Fprint(w, "final")
+		}
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	// Default behavior follows the whole chain.
+	resp, err := client.Get("/hop1").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "final", resp.String())
+
+	// FollowRedirects(false) returns the first redirect response.
+	resp, err = client.Get("/hop1").FollowRedirects(false).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, http.StatusFound, resp.StatusCode())
+
+	// MaxRedirects(1) fails on the second hop.
+	_, err = client.Get("/hop1").MaxRedirects(1).Send(context.Background())
+	assert.ErrorIs(t, err, ErrTooManyRedirects)
+
+	// MaxRedirects(2) is enough to reach the final target.
+	resp, err = client.Get("/hop1").MaxRedirects(2).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "final", resp.String())
+
+	// The client itself is untouched by per-request policies.
+	resp, err = client.Get("/hop1").Send(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, "final", resp.String())
+}
+
+func TestFileBodyStreamsWithoutBuffering(t *testing.T) {
+	content := strings.Repeat("payload ", 1024)
+	path := filepath.Join(t.TempDir(), "upload.bin")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing temp file: %v", err)
+	}
+
+	var gotLength int64
+	var gotBody string
+	var gotContentType string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLength = r.ContentLength
+		gotContentType = r.Header.Get("Content-Type")
+		data, _ := io.ReadAll(r.Body)
+		gotBody = string(data)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening temp file: %v", err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Post("/upload").Body(file).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, int64(len(content)), gotLength, "ContentLength should come from Stat, not chunked encoding")
+	assert.Equal(t, content, gotBody)
+	assert.Equal(t, "application/octet-stream", gotContentType)
+}
+
+func TestFileBodyRewindsOnRetry(t *testing.T) {
+	content := "file body for retry"
+	path := filepath.Join(t.TempDir(), "upload.bin")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing temp file: %v", err)
+	}
+
+	var hits int
+	var bodies []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		data, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(data))
+		if hits == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening temp file: %v", err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 2})
+	client.SetRetryStrategy(DefaultBackoffStrategy(0))
+	resp, err := client.Post("/upload").Body(file).Send(context.Background())
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, 2, hits)
+	for i, body := range bodies {
+		assert.Equal(t, content, body, "attempt %d body", i+1)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -11,7 +12,13 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goccy/go-yaml"
 )
 
 // Response represents an HTTP response.
@@ -23,11 +30,45 @@ type Response struct {
 	BodyBytes   []byte
 	Context     context.Context
 	Client      *Client
+
+	attempts            int
+	retryDelayTotal     time.Duration
+	totalDuration       time.Duration
+	lastAttemptDuration time.Duration
+	streamIdleTimeout   time.Duration
+	streamResume        func(lastOffset int64) *RequestBuilder
+	streamOffset        int64
+	streamResumesLeft   int
+	spillPath           string
+	tlsResumed          bool
+	usedHTTP1Fallback   bool
+	strictDecode        bool
+	unbuffered          bool
+
+	decodeMu    sync.Mutex
+	decodeCache map[reflect.Type]interface{}
+
+	// peeker buffers read-ahead for Peek on unbuffered bodies; decoders
+	// continue reading through it so peeked bytes are not lost.
+	peekMu sync.Mutex
+	peeker *bufio.Reader
 }
 
 // NewResponse creates a new wrapped response object, leveraging the buffer pool for efficient memory usage.
 func NewResponse(ctx context.Context, resp *http.Response, client *Client, stream StreamCallback, streamErr StreamErrCallback, streamDone StreamDoneCallback) (*Response, error) {
-	response := &Response{
+	response := newResponse(ctx, resp, client, stream, streamErr, streamDone)
+
+	if err := response.start(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// newResponse builds the wrapped response without starting body handling, so
+// callers can attach metadata before the stream goroutine runs.
+func newResponse(ctx context.Context, resp *http.Response, client *Client, stream StreamCallback, streamErr StreamErrCallback, streamDone StreamDoneCallback) *Response {
+	return &Response{
 		RawResponse: resp,
 		Context:     ctx,
 		BodyBytes:   nil,
@@ -36,38 +77,92 @@ func NewResponse(ctx context.Context, resp *http.Response, client *Client, strea
 		streamDone:  streamDone,
 		Client:      client,
 	}
+}
 
-	if response.stream != nil {
-		go response.handleStream()
-	} else {
-		if err := response.handleNonStream(); err != nil {
-			return nil, err
-		}
+// start begins body handling: streaming responses are consumed on a goroutine
+// and buffered responses are read eagerly.
+func (r *Response) start() error {
+	if r.stream != nil {
+		go r.handleStream()
+		return nil
 	}
-
-	return response, nil
+	if r.unbuffered {
+		// Leave the body on the wire for streaming decoders.
+		return nil
+	}
+	return r.handleNonStream()
 }
 
-// handleStream processes the HTTP response as a stream.
+// handleStream processes the HTTP response as a stream, delivering chunks at
+// flush boundaries as they arrive. Context cancellation and the configured
+// idle timeout close the body to stop the reader promptly.
 func (r *Response) handleStream() {
 	defer func() {
-		if err := r.RawResponse.Body.Close(); err != nil {
-			r.Client.Logger.Errorf("failed to close response body: %v", err)
-		}
+		_ = r.RawResponse.Body.Close()
 	}()
 
-	scanner := bufio.NewScanner(r.RawResponse.Body)
-	scanBuf := make([]byte, 0, maxStreamBufferSize)
-	scanner.Buffer(scanBuf, maxStreamBufferSize)
+	ctx := r.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	done := make(chan struct{})
+	defer close(done)
 
-	for scanner.Scan() {
-		if err := r.stream(scanner.Bytes()); err != nil {
-			break
+	// Unblock the reader as soon as the context is canceled.
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = r.RawResponse.Body.Close()
+		case <-done:
 		}
+	}()
+
+	// Close the body when no data arrives within the idle timeout.
+	var idleFired atomic.Bool
+	var idleTimer *time.Timer
+	if r.streamIdleTimeout > 0 {
+		idleTimer = time.AfterFunc(r.streamIdleTimeout, func() {
+			idleFired.Store(true)
+			_ = r.RawResponse.Body.Close()
+		})
+		defer idleTimer.Stop()
 	}
 
-	if err := scanner.Err(); err != nil && r.streamErr != nil {
-		r.streamErr(err)
+	buf := make([]byte, maxStreamBufferSize)
+	for {
+		n, err := r.RawResponse.Body.Read(buf)
+		if n > 0 {
+			if idleTimer != nil {
+				idleTimer.Reset(r.streamIdleTimeout)
+			}
+			r.streamOffset += int64(n)
+
+			chunk := bytes.TrimSuffix(buf[:n], []byte("\n"))
+			if cbErr := r.stream(chunk); cbErr != nil {
+				break
+			}
+		}
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				switch {
+				case idleFired.Load():
+					err = ErrStreamIdleTimeout
+				case ctx.Err() != nil:
+					err = ctx.Err()
+				}
+				// A dropped connection can be resumed from the current
+				// offset; the resumed stream owns the callbacks from here.
+				if ctx.Err() == nil && r.resumeStream() {
+					return
+				}
+				if r.streamErr != nil {
+					r.streamErr(err)
+				}
+			}
+			break
+		}
 	}
 
 	if r.streamDone != nil {
@@ -80,17 +175,116 @@ func (r *Response) handleNonStream() error {
 	buf := GetBuffer() // Use the buffer pool
 	defer PutBuffer(buf)
 
-	_, err := buf.ReadFrom(r.RawResponse.Body)
+	// Enforce the configured body cap: read one byte past the limit so a
+	// too-large body is detected without buffering all of it.
+	bodyReader := io.Reader(r.RawResponse.Body)
+	maxBody := r.Client.getLimits().MaxBodyBytes
+	if maxBody > 0 {
+		bodyReader = io.LimitReader(bodyReader, maxBody+1)
+	}
+
+	// Bodies past the spill threshold are buffered to disk instead of RAM.
+	// Only the head up to the threshold is read here; spillBody drains the
+	// rest straight into the file.
+	spillThreshold, spillDir := r.Client.getBodySpill()
+	if spillThreshold > 0 && r.Client.getBodyTransformer() == nil {
+		read, err := buf.ReadFrom(io.LimitReader(bodyReader, spillThreshold+1))
+		if err != nil {
+			if errors.Is(err, ErrChecksumMismatch) {
+				return err
+			}
+			return fmt.Errorf("%w: %v", ErrResponseReadFailed, err) //nolint:errorlint
+		}
+		if read > spillThreshold {
+			return r.spillBody(buf.B, bodyReader, maxBody, spillDir)
+		}
+		if maxBody > 0 && read > maxBody {
+			_ = r.RawResponse.Body.Close()
+			return fmt.Errorf("%w: body exceeds %d bytes", ErrBodyTooLarge, maxBody)
+		}
+		_ = r.RawResponse.Body.Close()
+
+		body := buf.B
+		r.RawResponse.Body = io.NopCloser(bytes.NewReader(body))
+		r.BodyBytes = body
+		return nil
+	}
+
+	read, err := buf.ReadFrom(bodyReader)
 	if err != nil {
+		if errors.Is(err, ErrChecksumMismatch) {
+			return err
+		}
 		return fmt.Errorf("%w: %v", ErrResponseReadFailed, err) //nolint:errorlint
 	}
+	if maxBody > 0 && read > maxBody {
+		_ = r.RawResponse.Body.Close()
+		return fmt.Errorf("%w: body exceeds %d bytes", ErrBodyTooLarge, maxBody)
+	}
 	_ = r.RawResponse.Body.Close()
 
-	r.RawResponse.Body = io.NopCloser(bytes.NewReader(buf.B))
-	r.BodyBytes = buf.B
+	body := buf.B
+
+	// Unwrap custom encodings (JWE, custom compression, embedded payloads)
+	// before any accessor sees the body.
+	if transform := r.Client.getBodyTransformer(); transform != nil {
+		transformed, contentType, transformErr := transform(r.ContentType(), body)
+		if transformErr != nil {
+			return fmt.Errorf("%w: %v", ErrBodyTransformFailed, transformErr) //nolint:errorlint
+		}
+		body = transformed
+		if contentType != "" {
+			r.RawResponse.Header.Set("Content-Type", contentType)
+		}
+	}
+
+	r.RawResponse.Body = io.NopCloser(bytes.NewReader(body))
+	r.BodyBytes = body
 	return nil
 }
 
+// Attempts returns the number of HTTP attempts the call took, including the
+// initial request.
+func (r *Response) Attempts() int {
+	return r.attempts
+}
+
+// RetryDelayTotal returns the total time spent waiting between retry attempts.
+func (r *Response) RetryDelayTotal() time.Duration {
+	return r.retryDelayTotal
+}
+
+// Duration returns the total wall time of the call, including retries and
+// the backoff delays between them.
+func (r *Response) Duration() time.Duration {
+	return r.totalDuration
+}
+
+// LastAttemptDuration returns the wall time of the attempt that produced
+// this response, without earlier attempts or backoff delays.
+func (r *Response) LastAttemptDuration() time.Duration {
+	return r.lastAttemptDuration
+}
+
+// TLSSessionResumed reports whether the TLS handshake for this call resumed a
+// previous session. It is false when no handshake happened, e.g. on reused or
+// plaintext connections.
+func (r *Response) TLSSessionResumed() bool {
+	return r.tlsResumed
+}
+
+// Proto returns the protocol the response was served over, e.g. "HTTP/2.0"
+// or "HTTP/1.1".
+func (r *Response) Proto() string {
+	return r.RawResponse.Proto
+}
+
+// UsedHTTP1Fallback reports whether the response was served by the HTTP/1.1
+// secondary transport after HTTP/2 negotiation failed.
+func (r *Response) UsedHTTP1Fallback() bool {
+	return r.usedHTTP1Fallback
+}
+
 // StatusCode returns the HTTP status code of the response.
 func (r *Response) StatusCode() int {
 	return r.RawResponse.StatusCode
@@ -148,6 +342,12 @@ func (r *Response) IsYAML() bool {
 
 // ContentLength returns the length of the response body.
 func (r *Response) ContentLength() int {
+	if r.spillPath != "" && r.BodyBytes == nil {
+		if info, err := os.Stat(r.spillPath); err == nil {
+			return int(info.Size())
+		}
+		return 0
+	}
 	if r.BodyBytes == nil {
 		return 0
 	}
@@ -165,20 +365,49 @@ func (r *Response) IsSuccess() bool {
 	return code >= 200 && code <= 299
 }
 
-// Body returns the response body as a byte slice.
+// Body returns the response body as a byte slice. A spilled body is read
+// back from disk and cached, so callers needing the full slice still work at
+// the cost of the memory the spill avoided.
 func (r *Response) Body() []byte {
+	if r.spillPath != "" && r.BodyBytes == nil {
+		data, err := os.ReadFile(r.spillPath)
+		if err != nil {
+			if logger := r.Client.getLogger(); logger != nil {
+				logger.Errorf("Error reading spilled body: %v", err)
+			}
+			return nil
+		}
+		r.BodyBytes = data
+	}
 	return r.BodyBytes
 }
 
 // String returns the response body as a string.
 func (r *Response) String() string {
-	return string(r.BodyBytes)
+	return string(r.Body())
 }
 
+// ContentFormat identifies a body format for decoding independent of the
+// response Content-Type header.
+type ContentFormat string
+
+// The content formats supported by Scan and ScanAs.
+const (
+	FormatJSON ContentFormat = "json"
+	FormatXML  ContentFormat = "xml"
+	FormatYAML ContentFormat = "yaml"
+)
+
 // Scan attempts to unmarshal the response body based on its content type.
+// When the header is absent or too generic to pick a decoder, the client's
+// scan fallback format is used if one is configured. JSON bodies go through
+// envelope unwrapping when the client has one configured.
 func (r *Response) Scan(v interface{}) error {
 	switch {
 	case r.IsJSON():
+		if envelope := r.Client.getResponseEnvelope(); envelope != nil {
+			return r.scanEnvelopedJSON(envelope, v)
+		}
 		return r.ScanJSON(v)
 	case r.IsXML():
 		return r.ScanXML(v)
@@ -186,19 +415,131 @@ func (r *Response) Scan(v interface{}) error {
 		return r.ScanYAML(v)
 	}
 
+	if fallback := r.Client.getScanFallback(); fallback != "" && isGenericContentType(r.ContentType()) {
+		return r.ScanAs(fallback, v)
+	}
+
 	return fmt.Errorf("%w: %s", ErrUnsupportedContentType, r.ContentType())
 }
 
+// Decoded decodes the body into v exactly as Scan does, memoizing the result
+// per concrete target type so handlers that read the same response in several
+// layers (logging, validation, business logic) don't pay repeated decode
+// cost. v must be a non-nil pointer. Cached values share underlying maps and
+// slices with earlier targets of the same type, so treat them as read-only.
+func (r *Response) Decoded(v interface{}) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return fmt.Errorf("%w: %T", ErrUnsupportedDataType, v)
+	}
+
+	r.decodeMu.Lock()
+	defer r.decodeMu.Unlock()
+
+	key := target.Type()
+	if cached, ok := r.decodeCache[key]; ok {
+		target.Elem().Set(reflect.ValueOf(cached))
+		return nil
+	}
+
+	if err := r.Scan(v); err != nil {
+		return err
+	}
+	if r.decodeCache == nil {
+		r.decodeCache = make(map[reflect.Type]interface{})
+	}
+	r.decodeCache[key] = target.Elem().Interface()
+	return nil
+}
+
+// ScanAs unmarshals the response body as the given format, ignoring the
+// response Content-Type header entirely.
+func (r *Response) ScanAs(format ContentFormat, v interface{}) error {
+	switch format {
+	case FormatJSON:
+		return r.ScanJSON(v)
+	case FormatXML:
+		return r.ScanXML(v)
+	case FormatYAML:
+		return r.ScanYAML(v)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedContentType, format)
+	}
+}
+
+// isGenericContentType reports whether a content type is absent or too
+// generic to select a decoder.
+func isGenericContentType(contentType string) bool {
+	switch {
+	case contentType == "":
+		return true
+	case strings.Contains(contentType, "application/octet-stream"),
+		strings.Contains(contentType, "text/plain"),
+		strings.Contains(contentType, "text/html"):
+		return true
+	}
+	return false
+}
+
+// JSONMap decodes the body into a map with json.Number semantics, so large
+// integer IDs keep their precision regardless of the configured decoder.
+func (r *Response) JSONMap() (map[string]any, error) {
+	result := make(map[string]any)
+	if r.BodyBytes == nil {
+		return result, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(r.BodyBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// isStrictDecode reports whether unknown body fields should fail decoding,
+// either for this request or client-wide.
+func (r *Response) isStrictDecode() bool {
+	return r.strictDecode || r.Client.getStrictDecode()
+}
+
 // ScanJSON unmarshals the response body into a struct via JSON decoding.
+// Spilled bodies decode straight from disk without loading into memory.
 func (r *Response) ScanJSON(v interface{}) error {
+	if r.spillPath != "" {
+		src, cleanup, err := r.bodySource()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		if r.isStrictDecode() {
+			decoder := json.NewDecoder(src)
+			decoder.DisallowUnknownFields()
+			return decoder.Decode(v)
+		}
+		return r.Client.JSONDecoder.Decode(src, v)
+	}
 	if r.BodyBytes == nil {
 		return nil
 	}
+	if r.isStrictDecode() {
+		decoder := json.NewDecoder(bytes.NewReader(r.BodyBytes))
+		decoder.DisallowUnknownFields()
+		return decoder.Decode(v)
+	}
 	return r.Client.JSONDecoder.Decode(bytes.NewReader(r.BodyBytes), v)
 }
 
 // ScanXML unmarshals the response body into a struct via XML decoding.
 func (r *Response) ScanXML(v interface{}) error {
+	if r.spillPath != "" {
+		src, cleanup, err := r.bodySource()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		return r.Client.XMLDecoder.Decode(src, v)
+	}
 	if r.BodyBytes == nil {
 		return nil
 	}
@@ -207,12 +548,57 @@ func (r *Response) ScanXML(v interface{}) error {
 
 // ScanYAML unmarshals the response body into a struct via YAML decoding.
 func (r *Response) ScanYAML(v interface{}) error {
+	if r.spillPath != "" {
+		if r.isStrictDecode() {
+			// Strict YAML decoding needs the full byte slice.
+			return yaml.UnmarshalWithOptions(r.Body(), v, yaml.Strict())
+		}
+		src, cleanup, err := r.bodySource()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		return r.Client.YAMLDecoder.Decode(src, v)
+	}
 	if r.BodyBytes == nil {
 		return nil
 	}
+	if r.isStrictDecode() {
+		return yaml.UnmarshalWithOptions(r.BodyBytes, v, yaml.Strict())
+	}
 	return r.Client.YAMLDecoder.Decode(bytes.NewReader(r.BodyBytes), v)
 }
 
+// Tee writes the buffered response body to w, so the raw payload can be
+// archived (e.g. for audit logging) without re-reading the body. The body
+// remains available for Scan and Body afterwards.
+func (r *Response) Tee(w io.Writer) error {
+	if r.spillPath != "" {
+		src, cleanup, err := r.bodySource()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		_, err = io.Copy(w, src)
+		return err
+	}
+	if r.BodyBytes == nil {
+		return nil
+	}
+	_, err := w.Write(r.BodyBytes)
+	return err
+}
+
+// ScanAndSave archives the raw body to w and decodes it into v in one step.
+// The body is written even when decoding fails, so the offending payload is
+// preserved.
+func (r *Response) ScanAndSave(v any, w io.Writer) error {
+	if err := r.Tee(w); err != nil {
+		return err
+	}
+	return r.Scan(v)
+}
+
 const dirPermissions = 0o750
 
 // Save saves the response body to a file or io.Writer.
@@ -244,17 +630,26 @@ func (r *Response) Save(v any) error {
 			}
 		}()
 
-		// Write the response body to the file
-		_, err = io.Copy(outFile, bytes.NewReader(r.Body()))
+		// Write the response body to the file, straight from disk when the
+		// body was spilled.
+		src, cleanup, err := r.bodySource()
 		if err != nil {
+			return err
+		}
+		defer cleanup()
+		if _, err = io.Copy(outFile, src); err != nil {
 			return fmt.Errorf("failed to write response body to file: %w", err)
 		}
 
 		return nil
 	case io.Writer:
 		// Write the response body directly to the provided io.Writer
-		_, err := io.Copy(p, bytes.NewReader(r.Body()))
+		src, cleanup, err := r.bodySource()
 		if err != nil {
+			return err
+		}
+		defer cleanup()
+		if _, err := io.Copy(p, src); err != nil {
 			return fmt.Errorf("failed to write response body to io.Writer: %w", err)
 		}
 		// If the writer can be closed, close it
@@ -271,7 +666,13 @@ func (r *Response) Save(v any) error {
 	}
 }
 
-// Close closes the response body.
+// Close closes the response body and removes the spill file, if any.
 func (r *Response) Close() error {
-	return r.RawResponse.Body.Close()
+	err := r.RawResponse.Body.Close()
+	if r.spillPath != "" {
+		if removeErr := os.Remove(r.spillPath); removeErr != nil && err == nil {
+			err = removeErr
+		}
+	}
+	return err
 }
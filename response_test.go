@@ -3,6 +3,8 @@ package requests
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"net/http"
@@ -12,6 +14,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResponseContentType(t *testing.T) {
@@ -359,3 +362,340 @@ func TestResponseSaveToWriter(t *testing.T) {
 		t.Errorf("Expected buffer content %q, got %q", expected, buffer.String())
 	}
 }
+
+func TestScanAsForcedFormat(t *testing.T) {
+	// The server reports a generic content type but sends JSON.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		fmt.Fprint(w, `{"name":"octet"}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	assert.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var result struct {
+		Name string `json:"name"`
+	}
+	assert.NoError(t, resp.ScanAs(FormatJSON, &result))
+	assert.Equal(t, "octet", result.Name)
+
+	// Unknown formats surface an unsupported content type error.
+	assert.ErrorIs(t, resp.ScanAs(ContentFormat("csv"), &result), ErrUnsupportedContentType)
+}
+
+func TestScanFallbackFormat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// text/html that is actually JSON, as some misbehaving servers send.
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `{"name":"fallback"}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	// Without a fallback, Scan fails on the generic content type.
+	resp, err := client.Get("/").Send(context.Background())
+	assert.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var result struct {
+		Name string `json:"name"`
+	}
+	assert.ErrorIs(t, resp.Scan(&result), ErrUnsupportedContentType)
+
+	// With the fallback configured, Scan decodes as JSON.
+	client.SetScanFallback(FormatJSON)
+	assert.NoError(t, resp.Scan(&result))
+	assert.Equal(t, "fallback", result.Name)
+}
+
+func TestJSONMapPreservesNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":9007199254740993,"name":"widget"}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	payload, err := resp.JSONMap()
+	require.NoError(t, err)
+
+	number, ok := payload["id"].(json.Number)
+	require.True(t, ok, "expected json.Number, got %T", payload["id"])
+	id, err := number.Int64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), id)
+}
+
+func TestSetJSONDecodeOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":9007199254740993,"extra":true}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetJSONDecodeOptions(true, true)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	// UseNumber keeps precision when scanning into a map.
+	var payload map[string]any
+	require.NoError(t, resp.ScanJSON(&payload))
+	_, ok := payload["id"].(json.Number)
+	assert.True(t, ok)
+
+	// DisallowUnknownFields rejects fields missing from the target struct.
+	var target struct {
+		ID json.Number `json:"id"`
+	}
+	assert.Error(t, resp.ScanJSON(&target))
+}
+
+func TestStrictDecode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name":"widget","unexpected":true}`)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	var target struct {
+		Name string `json:"name"`
+	}
+
+	// Default decoding tolerates unknown fields.
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	require.NoError(t, resp.Scan(&target))
+	assert.Equal(t, "widget", target.Name)
+
+	// Per-request strict mode rejects them.
+	resp, err = client.Get("/").StrictDecode().Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Error(t, resp.Scan(&target))
+
+	// Client-wide strict mode applies to every request.
+	client.SetStrictDecode(true)
+	resp, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Error(t, resp.Scan(&target))
+}
+
+func TestStrictDecodeYAML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		fmt.Fprint(w, "name: widget\nunexpected: true\n")
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	var target struct {
+		Name string `yaml:"name"`
+	}
+
+	resp, err := client.Get("/").StrictDecode().Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Error(t, resp.Scan(&target))
+}
+
+func TestScanAndSave(t *testing.T) {
+	body := `{"message": "archived", "status": true}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var decoded struct {
+		Message string `json:"message"`
+	}
+	var archive bytes.Buffer
+	require.NoError(t, resp.ScanAndSave(&decoded, &archive))
+	assert.Equal(t, "archived", decoded.Message)
+	assert.Equal(t, body, archive.String())
+
+	// Tee can be called again; the body is still buffered.
+	var second bytes.Buffer
+	require.NoError(t, resp.Tee(&second))
+	assert.Equal(t, body, second.String())
+}
+
+func TestScanAndSaveArchivesOnDecodeError(t *testing.T) {
+	body := `not json at all`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	var decoded map[string]any
+	var archive bytes.Buffer
+	assert.Error(t, resp.ScanAndSave(&decoded, &archive))
+	// The raw payload is preserved even though decoding failed.
+	assert.Equal(t, body, archive.String())
+}
+
+func TestDecodedMemoizesPerType(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"memo","count":3}`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	type payload struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	var first payload
+	require.NoError(t, resp.Decoded(&first))
+	assert.Equal(t, payload{Name: "memo", Count: 3}, first)
+
+	// A second read of the same type is served from the cache even after
+	// the raw bytes are gone.
+	saved := resp.BodyBytes
+	resp.BodyBytes = nil
+	var second payload
+	require.NoError(t, resp.Decoded(&second))
+	assert.Equal(t, first, second)
+	resp.BodyBytes = saved
+
+	// Different target types decode independently.
+	var asMap map[string]interface{}
+	require.NoError(t, resp.Decoded(&asMap))
+	assert.Equal(t, "memo", asMap["name"])
+
+	// Non-pointer targets are rejected.
+	assert.ErrorIs(t, resp.Decoded(payload{}), ErrUnsupportedDataType)
+}
+
+func newBenchmarkResponse(b *testing.B, body string) *Response {
+	b.Helper()
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	b.Cleanup(mockServer.Close)
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	if err != nil {
+		b.Fatalf("Failed to send request: %v", err)
+	}
+	b.Cleanup(func() { _ = resp.Close() })
+	return resp
+}
+
+func BenchmarkScanRepeated(b *testing.B) {
+	resp := newBenchmarkResponse(b, `{"name":"memo","count":3,"tags":["a","b","c"]}`)
+	type payload struct {
+		Name  string   `json:"name"`
+		Count int      `json:"count"`
+		Tags  []string `json:"tags"`
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var v payload
+		if err := resp.Scan(&v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodedRepeated(b *testing.B) {
+	resp := newBenchmarkResponse(b, `{"name":"memo","count":3,"tags":["a","b","c"]}`)
+	type payload struct {
+		Name  string   `json:"name"`
+		Count int      `json:"count"`
+		Tags  []string `json:"tags"`
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var v payload
+		if err := resp.Decoded(&v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestSetBodyTransformer(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/base64+json")
+		_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString([]byte(`{"wrapped":true}`))))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetBodyTransformer(func(contentType string, body []byte) ([]byte, string, error) {
+		if contentType != "application/base64+json" {
+			return body, "", nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(string(body))
+		if err != nil {
+			return nil, "", err
+		}
+		return decoded, "application/json", nil
+	})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	// Accessors and Scan see the unwrapped body and content type.
+	assert.Equal(t, "application/json", resp.ContentType())
+	var v struct {
+		Wrapped bool `json:"wrapped"`
+	}
+	require.NoError(t, resp.Scan(&v))
+	assert.True(t, v.Wrapped)
+}
+
+func TestSetBodyTransformerError(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not-base64!"))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetBodyTransformer(func(contentType string, body []byte) ([]byte, string, error) {
+		_, err := base64.StdEncoding.DecodeString(string(body))
+		return nil, "", err
+	})
+
+	_, err := client.Get("/").Send(context.Background())
+	assert.ErrorIs(t, err, ErrBodyTransformFailed)
+}
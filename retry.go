@@ -1,8 +1,13 @@
 package requests
 
 import (
+	"context"
+	"errors"
 	"math"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 )
 
@@ -35,6 +40,85 @@ func ExponentialBackoffStrategy(initialInterval time.Duration, multiplier float6
 	}
 }
 
+// FullJitterBackoff implements the AWS-style "full jitter" strategy: each
+// delay is drawn uniformly from [0, min(cap, base*2^attempt)], spreading
+// retries across the whole window to avoid thundering herds.
+func FullJitterBackoff(base, cap time.Duration) BackoffStrategy {
+	return func(attempt int) time.Duration {
+		ceiling := cap
+		if attempt < 62 {
+			if exp := base << uint(attempt); exp > 0 && exp < cap {
+				ceiling = exp
+			}
+		}
+		if ceiling <= 0 {
+			return 0
+		}
+		return time.Duration(rand.Int63n(int64(ceiling) + 1)) //nolint:gosec // Jitter, not security.
+	}
+}
+
+// DecorrelatedJitterBackoff implements the AWS-style "decorrelated jitter"
+// strategy: each delay is drawn uniformly from [base, prev*3] and capped,
+// so consecutive delays grow without synchronizing across clients. The
+// returned strategy keeps state and must not be shared between clients.
+func DecorrelatedJitterBackoff(base, cap time.Duration) BackoffStrategy {
+	var mu sync.Mutex
+	prev := base
+	return func(attempt int) time.Duration {
+		mu.Lock()
+		defer mu.Unlock()
+
+		upper := prev * 3
+		if upper < base {
+			upper = base
+		}
+		delay := base
+		if spread := int64(upper - base); spread > 0 {
+			delay += time.Duration(rand.Int63n(spread + 1)) //nolint:gosec // Jitter, not security.
+		}
+		if delay > cap {
+			delay = cap
+		}
+		prev = delay
+		return delay
+	}
+}
+
+// BackoffFromResponse derives the next retry delay from the failed response,
+// composed with the configured strategy: it receives the response (which may
+// carry headers like Retry-After or X-RateLimit-Reset) and the delay the
+// strategy computed, and returns the delay to use.
+type BackoffFromResponse func(resp *http.Response, computed time.Duration) time.Duration
+
+// RetryAfterBackoff is a BackoffFromResponse that honors the Retry-After and
+// X-RateLimit-Reset headers when present, falling back to the computed delay.
+func RetryAfterBackoff(resp *http.Response, computed time.Duration) time.Duration {
+	if resp == nil {
+		return computed
+	}
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(value); err == nil {
+			if delay := time.Until(at); delay > 0 {
+				return delay
+			}
+			return 0
+		}
+	}
+	if value := resp.Header.Get("X-RateLimit-Reset"); value != "" {
+		if reset, err := strconv.ParseInt(value, 10, 64); err == nil {
+			if delay := time.Until(time.Unix(reset, 0)); delay > 0 {
+				return delay
+			}
+			return 0
+		}
+	}
+	return computed
+}
+
 // RetryConfig defines the configuration for retrying requests.
 type RetryConfig struct {
 	MaxRetries int             // Maximum number of retry attempts
@@ -45,7 +129,66 @@ type RetryConfig struct {
 // RetryIfFunc defines the function signature for retry conditions.
 type RetryIfFunc func(req *http.Request, resp *http.Response, err error) bool
 
-// DefaultRetryIf is a simple retry condition that retries on 5xx status codes.
+// RetryHook is called before each retry wait with the 1-based number of the
+// attempt that just failed, the request, and the failed response or error.
+type RetryHook func(attempt int, req *http.Request, resp *http.Response, err error)
+
+// retryStats records how many attempts a call took and the total backoff delay,
+// so they can be surfaced on the Response.
+type retryStats struct {
+	attempts      int
+	delayTotal    time.Duration
+	lastAttempt   time.Duration
+	http1Fallback bool
+}
+
+// attemptContextKey keys the per-attempt number stored on the request context
+// by retry loops that re-run middleware for each attempt.
+type attemptContextKey struct{}
+
+// WithAttempt returns a context carrying the 1-based attempt number, so
+// middleware running inside a retry loop can see which attempt it is handling.
+func WithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
+
+// AttemptFromContext returns the attempt number recorded on the context, or 1
+// when the request is not inside an attempt-aware retry loop.
+func AttemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptContextKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
+
+// StandardRetryPolicy returns the default retry condition so callers can
+// compose it with their own checks.
+func StandardRetryPolicy() RetryIfFunc {
+	return DefaultRetryIf
+}
+
+// DefaultRetryIf retries on transport errors and on 408, 429, and 5xx status
+// codes, except 501 Not Implemented. Requests canceled or timed out by the
+// caller are not retried, and a nil response without an error is not retried.
 func DefaultRetryIf(req *http.Request, resp *http.Response, err error) bool {
-	return resp.StatusCode >= 500 || err != nil
+	if err != nil {
+		// Respect caller cancellation and deadlines.
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return true
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	case http.StatusNotImplemented:
+		return false
+	}
+
+	return resp.StatusCode >= 500
 }
@@ -0,0 +1,178 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRetryIf(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	tests := []struct {
+		name     string
+		resp     *http.Response
+		err      error
+		expected bool
+	}{
+		{
+			name:     "transport error",
+			err:      errors.New("connection reset"),
+			expected: true,
+		},
+		{
+			name:     "context canceled not retried",
+			err:      context.Canceled,
+			expected: false,
+		},
+		{
+			name:     "deadline exceeded not retried",
+			err:      context.DeadlineExceeded,
+			expected: false,
+		},
+		{
+			name:     "nil response without error does not panic",
+			expected: false,
+		},
+		{
+			name:     "500 retried",
+			resp:     &http.Response{StatusCode: http.StatusInternalServerError},
+			expected: true,
+		},
+		{
+			name:     "501 not retried",
+			resp:     &http.Response{StatusCode: http.StatusNotImplemented},
+			expected: false,
+		},
+		{
+			name:     "429 retried",
+			resp:     &http.Response{StatusCode: http.StatusTooManyRequests},
+			expected: true,
+		},
+		{
+			name:     "408 retried",
+			resp:     &http.Response{StatusCode: http.StatusRequestTimeout},
+			expected: true,
+		},
+		{
+			name:     "200 not retried",
+			resp:     &http.Response{StatusCode: http.StatusOK},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, DefaultRetryIf(req, tc.resp, tc.err))
+		})
+	}
+}
+
+func TestDeadlineBudgetBoundsRetries(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	start := time.Now()
+	_, err := client.Get("/").
+		MaxRetries(50).
+		RetryStrategy(func(attempt int) time.Duration { return 50 * time.Millisecond }).
+		RetryIf(DefaultRetryIf).
+		DeadlineBudget(200 * time.Millisecond).
+		Send(context.Background())
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestDeadlineBudgetPerAttemptTimeout(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	start := time.Now()
+	_, err := client.Get("/").
+		MaxRetries(2).
+		RetryStrategy(func(attempt int) time.Duration { return 10 * time.Millisecond }).
+		Timeout(100 * time.Millisecond).
+		DeadlineBudget(time.Minute).
+		Send(context.Background())
+
+	// Each attempt is cut off by the per-attempt timeout, so three attempts
+	// plus backoff finish well before any server response arrives.
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestFullJitterBackoffBounds(t *testing.T) {
+	strategy := FullJitterBackoff(100*time.Millisecond, time.Second)
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := strategy(attempt)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, time.Second)
+	}
+}
+
+func TestDecorrelatedJitterBackoffBounds(t *testing.T) {
+	base := 50 * time.Millisecond
+	capDelay := 500 * time.Millisecond
+	strategy := DecorrelatedJitterBackoff(base, capDelay)
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := strategy(attempt)
+		assert.GreaterOrEqual(t, delay, base)
+		assert.LessOrEqual(t, delay, capDelay)
+	}
+}
+
+func TestRetryAfterBackoff(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	assert.Equal(t, time.Minute, RetryAfterBackoff(resp, time.Minute), "no headers falls back")
+
+	resp.Header.Set("Retry-After", "2")
+	assert.Equal(t, 2*time.Second, RetryAfterBackoff(resp, time.Minute))
+
+	resp.Header.Del("Retry-After")
+	resp.Header.Set("X-RateLimit-Reset", "1")
+	assert.Equal(t, time.Duration(0), RetryAfterBackoff(resp, time.Minute), "past reset yields no delay")
+
+	assert.Equal(t, time.Minute, RetryAfterBackoff(nil, time.Minute))
+}
+
+func TestSetBackoffFromResponseHonorsRetryAfter(t *testing.T) {
+	var hits int
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 2})
+	client.SetRetryStrategy(DefaultBackoffStrategy(5 * time.Second))
+	client.SetBackoffFromResponse(RetryAfterBackoff)
+
+	start := time.Now()
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, 2, hits)
+	assert.Less(t, time.Since(start), 2*time.Second, "Retry-After should override the 5s strategy delay")
+}
@@ -0,0 +1,231 @@
+package requests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// robotsRule is a single Allow/Disallow path prefix from a robots.txt group.
+type robotsRule struct {
+	path  string
+	allow bool
+}
+
+// robotsPolicy is the parsed robots.txt policy applying to one user agent on
+// one host.
+type robotsPolicy struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// allowed reports whether a path may be fetched; the longest matching prefix
+// wins, and a path matched by no rule is allowed.
+func (p *robotsPolicy) allowed(path string) bool {
+	if path == "" {
+		path = "/"
+	}
+
+	allow := true
+	bestLen := -1
+	for _, rule := range p.rules {
+		if strings.HasPrefix(path, rule.path) && len(rule.path) > bestLen {
+			allow = rule.allow
+			bestLen = len(rule.path)
+		}
+	}
+	return allow
+}
+
+// parseRobotsTxt extracts the policy for the given user agent, preferring a
+// group naming the agent over the wildcard group.
+func parseRobotsTxt(data, userAgent string) *robotsPolicy {
+	type group struct {
+		agents []string
+		policy robotsPolicy
+	}
+
+	var groups []*group
+	var current *group
+	inAgents := false
+
+	for _, line := range strings.Split(data, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		field, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		field = strings.ToLower(strings.TrimSpace(field))
+		value = strings.TrimSpace(value)
+
+		switch field {
+		case "user-agent":
+			if !inAgents {
+				current = &group{}
+				groups = append(groups, current)
+			}
+			inAgents = true
+			current.agents = append(current.agents, strings.ToLower(value))
+			continue
+		case "allow", "disallow":
+			if current == nil || value == "" {
+				inAgents = false
+				continue
+			}
+			current.policy.rules = append(current.policy.rules, robotsRule{path: value, allow: field == "allow"})
+		case "crawl-delay":
+			if current == nil {
+				continue
+			}
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.policy.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		}
+		inAgents = false
+	}
+
+	agent := strings.ToLower(userAgent)
+	var wildcard *robotsPolicy
+	for _, g := range groups {
+		for _, name := range g.agents {
+			if name == "*" {
+				if wildcard == nil {
+					wildcard = &g.policy
+				}
+				continue
+			}
+			if strings.Contains(agent, name) {
+				return &g.policy
+			}
+		}
+	}
+	if wildcard != nil {
+		return wildcard
+	}
+	return &robotsPolicy{}
+}
+
+// robotsGuard caches robots.txt policies per host and throttles requests to
+// honor crawl-delay.
+type robotsGuard struct {
+	mu          sync.Mutex
+	client      *Client
+	userAgent   string
+	policies    map[string]*robotsPolicy
+	lastRequest map[string]time.Time
+}
+
+// policy returns the cached policy for a host, fetching robots.txt on first
+// use. Fetch failures and non-2xx answers allow everything.
+func (g *robotsGuard) policy(req *http.Request) *robotsPolicy {
+	host := req.URL.Host
+
+	g.mu.Lock()
+	if policy, ok := g.policies[host]; ok {
+		g.mu.Unlock()
+		return policy
+	}
+	g.mu.Unlock()
+
+	policy := g.fetch(req)
+
+	g.mu.Lock()
+	g.policies[host] = policy
+	g.mu.Unlock()
+	return policy
+}
+
+// fetch retrieves and parses robots.txt for the request's host.
+func (g *robotsGuard) fetch(req *http.Request) *robotsPolicy {
+	robotsURL := req.URL.Scheme + "://" + req.URL.Host + "/robots.txt"
+	robotsReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, robotsURL, nil)
+	if err != nil {
+		return &robotsPolicy{}
+	}
+	if g.userAgent != "" {
+		robotsReq.Header.Set("User-Agent", g.userAgent)
+	}
+
+	resp, err := g.client.getHTTPClient().Do(robotsReq)
+	if err != nil {
+		return &robotsPolicy{}
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &robotsPolicy{}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &robotsPolicy{}
+	}
+	return parseRobotsTxt(string(body), g.userAgent)
+}
+
+// waitCrawlDelay blocks until the host's crawl-delay has elapsed since the
+// previous request, or the context is done.
+func (g *robotsGuard) waitCrawlDelay(req *http.Request, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+
+	host := req.URL.Host
+	g.mu.Lock()
+	wait := delay - time.Since(g.lastRequest[host])
+	if wait <= 0 {
+		// First request for the host, or the delay already elapsed: the
+		// request goes out now, so record now — not a time in the past that
+		// would poison later computations.
+		g.lastRequest[host] = time.Now()
+		g.mu.Unlock()
+		return nil
+	}
+	g.lastRequest[host] = time.Now().Add(wait)
+	g.mu.Unlock()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+// middleware enforces the robots policy for each outgoing request.
+func (g *robotsGuard) middleware(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/robots.txt" {
+			return next(req)
+		}
+
+		policy := g.policy(req)
+		if !policy.allowed(req.URL.Path) {
+			return nil, fmt.Errorf("%w: %s", ErrRobotsDisallowed, req.URL.String())
+		}
+		if err := g.waitCrawlDelay(req, policy.crawlDelay); err != nil {
+			return nil, err
+		}
+		return next(req)
+	}
+}
+
+// RespectRobotsTxt installs a named "robots" middleware that fetches and
+// caches robots.txt per host, blocks disallowed paths with
+// ErrRobotsDisallowed, and honors crawl-delay by throttling per host. The
+// user agent is used both to pick the robots.txt group and to fetch the file.
+func (c *Client) RespectRobotsTxt(userAgent string) *Client {
+	guard := &robotsGuard{
+		client:      c,
+		userAgent:   userAgent,
+		policies:    make(map[string]*robotsPolicy),
+		lastRequest: make(map[string]time.Time),
+	}
+	return c.UseNamed("robots", guard.middleware)
+}
@@ -0,0 +1,92 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRobotsTxt(t *testing.T) {
+	robots := `
+# comment
+User-agent: badbot
+Disallow: /
+
+User-agent: mybot
+Disallow: /private/
+Allow: /private/public/
+Crawl-delay: 2
+
+User-agent: *
+Disallow: /admin/
+`
+
+	policy := parseRobotsTxt(robots, "mybot/1.0")
+	assert.True(t, policy.allowed("/"))
+	assert.False(t, policy.allowed("/private/data"))
+	assert.True(t, policy.allowed("/private/public/page"))
+	assert.True(t, policy.allowed("/admin/")) // The mybot group takes precedence.
+	assert.Equal(t, 2*time.Second, policy.crawlDelay)
+
+	wildcard := parseRobotsTxt(robots, "otherbot")
+	assert.False(t, wildcard.allowed("/admin/panel"))
+	assert.True(t, wildcard.allowed("/public"))
+}
+
+func TestRespectRobotsTxt(t *testing.T) {
+	var robotsFetches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			atomic.AddInt32(&robotsFetches, 1)
+			fmt.Fprint(w, "User-agent: *\nDisallow: /secret/\n")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL}).RespectRobotsTxt("testbot")
+
+	resp, err := client.Get("/allowed").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.True(t, resp.IsSuccess())
+
+	_, err = client.Get("/secret/page").Send(context.Background())
+	assert.ErrorIs(t, err, ErrRobotsDisallowed)
+
+	// robots.txt is fetched once per host.
+	resp, err = client.Get("/also-allowed").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.Equal(t, int32(1), atomic.LoadInt32(&robotsFetches))
+}
+
+func TestRespectRobotsTxtCrawlDelay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			fmt.Fprint(w, "User-agent: *\nCrawl-delay: 0.1\n")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL}).RespectRobotsTxt("testbot")
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+		resp.Close() //nolint: errcheck
+	}
+	// Three requests with a 100ms crawl-delay take at least ~200ms.
+	assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond)
+}
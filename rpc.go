@@ -0,0 +1,124 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+)
+
+// rpcID issues unique JSON-RPC request ids across all clients.
+var rpcID atomic.Int64
+
+// RPCError is a typed JSON-RPC 2.0 error object returned by the server.
+type RPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// rpcRequest is the JSON-RPC 2.0 request envelope.
+type rpcRequest struct {
+	Version string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+	ID      int64       `json:"id"`
+}
+
+// rpcResponse is the JSON-RPC 2.0 response envelope.
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+	ID     int64           `json:"id"`
+}
+
+// RPCCall performs a JSON-RPC 2.0 call against the path, managing request ids
+// automatically. Server-side failures surface as *RPCError; a nil result
+// skips decoding.
+func (c *Client) RPCCall(ctx context.Context, path, method string, params, result interface{}) error {
+	envelope := rpcRequest{Version: "2.0", Method: method, Params: params, ID: rpcID.Add(1)}
+
+	resp, err := c.Post(path).JSONBody(envelope).Send(ctx)
+	if err != nil {
+		return err
+	}
+	defer resp.Close() //nolint:errcheck
+
+	var out rpcResponse
+	if err := resp.ScanJSON(&out); err != nil {
+		return err
+	}
+	if out.Error != nil {
+		return out.Error
+	}
+	return decodeRPCResult(c, out.Result, result)
+}
+
+// RPCBatchCall describes one call inside an RPCBatch. Result receives the
+// decoded result when non-nil, and Err carries the per-call failure.
+type RPCBatchCall struct {
+	Method string
+	Params interface{}
+	Result interface{}
+	Err    error
+}
+
+// RPCBatch performs several JSON-RPC 2.0 calls in one request, matching
+// responses to calls by id regardless of order. Transport failures are
+// returned; per-call failures land on each call's Err field.
+func (c *Client) RPCBatch(ctx context.Context, path string, calls ...*RPCBatchCall) error {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	envelopes := make([]rpcRequest, len(calls))
+	byID := make(map[int64]*RPCBatchCall, len(calls))
+	for i, call := range calls {
+		id := rpcID.Add(1)
+		envelopes[i] = rpcRequest{Version: "2.0", Method: call.Method, Params: call.Params, ID: id}
+		byID[id] = call
+	}
+
+	resp, err := c.Post(path).JSONBody(envelopes).Send(ctx)
+	if err != nil {
+		return err
+	}
+	defer resp.Close() //nolint:errcheck
+
+	var out []rpcResponse
+	if err := resp.ScanJSON(&out); err != nil {
+		return err
+	}
+
+	for i := range out {
+		call, ok := byID[out[i].ID]
+		if !ok {
+			continue
+		}
+		delete(byID, out[i].ID)
+		if out[i].Error != nil {
+			call.Err = out[i].Error
+			continue
+		}
+		call.Err = decodeRPCResult(c, out[i].Result, call.Result)
+	}
+	for _, call := range byID {
+		call.Err = fmt.Errorf("%w: no response for call %q", ErrRPCIncomplete, call.Method)
+	}
+	return nil
+}
+
+// decodeRPCResult decodes a raw result into the destination via the client's
+// JSON decoder, so custom decoders apply to RPC results too.
+func decodeRPCResult(c *Client, raw json.RawMessage, result interface{}) error {
+	if result == nil || len(raw) == 0 {
+		return nil
+	}
+	return c.JSONDecoder.Decode(bytes.NewReader(raw), result)
+}
@@ -0,0 +1,160 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCCall(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "2.0", req["jsonrpc"])
+		assert.Equal(t, "math.add", req["method"])
+		assert.NotNil(t, req["id"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"result":  7,
+			"id":      req["id"],
+		})
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	var result int
+	require.NoError(t, client.RPCCall(context.Background(), "/rpc", "math.add", []int{3, 4}, &result))
+	assert.Equal(t, 7, result)
+}
+
+func TestRPCCallError(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"error":   map[string]interface{}{"code": -32601, "message": "method not found"},
+			"id":      req["id"],
+		})
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	err := client.RPCCall(context.Background(), "/rpc", "missing", nil, nil)
+	require.Error(t, err)
+
+	var rpcErr *RPCError
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, -32601, rpcErr.Code)
+	assert.Equal(t, "method not found", rpcErr.Message)
+}
+
+func TestRPCBatchMatchesResponsesByID(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqs []map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqs))
+		require.Len(t, reqs, 2)
+
+		// Answer in reverse order; the second call fails.
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{
+				"jsonrpc": "2.0",
+				"error":   map[string]interface{}{"code": 1, "message": "boom"},
+				"id":      reqs[1]["id"],
+			},
+			{
+				"jsonrpc": "2.0",
+				"result":  "ok",
+				"id":      reqs[0]["id"],
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	var first string
+	calls := []*RPCBatchCall{
+		{Method: "status", Result: &first},
+		{Method: "explode"},
+	}
+	require.NoError(t, client.RPCBatch(context.Background(), "/rpc", calls...))
+
+	require.NoError(t, calls[0].Err)
+	assert.Equal(t, "ok", first)
+
+	var rpcErr *RPCError
+	require.ErrorAs(t, calls[1].Err, &rpcErr)
+	assert.Equal(t, "boom", rpcErr.Message)
+}
+
+func TestRPCBatchMissingResponse(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	call := &RPCBatchCall{Method: "status"}
+	require.NoError(t, client.RPCBatch(context.Background(), "/rpc", call))
+	assert.ErrorIs(t, call.Err, ErrRPCIncomplete)
+}
+
+func TestXMLRPCCall(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Content-Type"), "text/xml")
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(`<?xml version="1.0"?>
+<methodResponse><params><param><value><struct>
+<member><name>state</name><value><string>RUNNING</string></value></member>
+<member><name>pid</name><value><int>4711</int></value></member>
+</struct></value></param></params></methodResponse>`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	var result interface{}
+	err := client.XMLRPCCall(context.Background(), "/RPC2", "supervisor.getProcessInfo", []interface{}{"web"}, &result)
+	require.NoError(t, err)
+
+	info, ok := result.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "RUNNING", info["state"])
+	assert.Equal(t, 4711, info["pid"])
+}
+
+func TestXMLRPCFault(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(`<?xml version="1.0"?>
+<methodResponse><fault><value><struct>
+<member><name>faultCode</name><value><int>10</int></value></member>
+<member><name>faultString</name><value><string>BAD_NAME</string></value></member>
+</struct></value></fault></methodResponse>`))
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	err := client.XMLRPCCall(context.Background(), "/RPC2", "supervisor.startProcess", []interface{}{"nope"}, nil)
+	require.Error(t, err)
+
+	var fault *XMLRPCFault
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, 10, fault.Code)
+	assert.Equal(t, "BAD_NAME", fault.String)
+}
@@ -0,0 +1,171 @@
+package requests
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// SchedulerStats is a snapshot of the request scheduler's activity.
+type SchedulerStats struct {
+	QueueLength    int           // Requests currently waiting for a slot
+	Queued         int64         // Requests that had to wait since the scheduler was installed
+	QueueWaitTotal time.Duration // Total time requests spent waiting
+}
+
+// waiter is a queued request waiting for a scheduler slot.
+type waiter struct {
+	priority   int
+	seq        uint64
+	index      int
+	enqueuedAt time.Time
+	ready      chan struct{}
+}
+
+// waiterQueue is a max-heap of waiters ordered by priority, FIFO within the
+// same priority.
+type waiterQueue []*waiter
+
+func (q waiterQueue) Len() int { return len(q) }
+
+func (q waiterQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q waiterQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waiterQueue) Push(x any) {
+	w := x.(*waiter) //nolint:errcheck // Only waiters are pushed.
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waiterQueue) Pop() any {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	w.index = -1
+	*q = old[:n-1]
+	return w
+}
+
+// scheduler bounds the number of concurrent requests and admits queued
+// requests in priority order.
+type scheduler struct {
+	mu      sync.Mutex
+	limit   int
+	running int
+	seq     uint64
+	queue   waiterQueue
+
+	queued         int64
+	queueWaitTotal time.Duration
+}
+
+// acquire blocks until a slot is available or the context is done. Requests
+// beyond the concurrency limit queue and are dispatched highest priority
+// first.
+func (s *scheduler) acquire(ctx context.Context, priority int) error {
+	s.mu.Lock()
+	if s.running < s.limit {
+		s.running++
+		s.mu.Unlock()
+		return nil
+	}
+
+	s.seq++
+	w := &waiter{
+		priority:   priority,
+		seq:        s.seq,
+		enqueuedAt: time.Now(),
+		ready:      make(chan struct{}),
+	}
+	heap.Push(&s.queue, w)
+	s.queued++
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		if w.index >= 0 {
+			// Still queued: abandon the slot request.
+			heap.Remove(&s.queue, w.index)
+			s.mu.Unlock()
+			return ctx.Err()
+		}
+		s.mu.Unlock()
+		// Already admitted while the context fired; release the slot.
+		<-w.ready
+		s.release()
+		return ctx.Err()
+	}
+}
+
+// release frees a slot, handing it to the highest-priority waiter if any.
+func (s *scheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.queue.Len() > 0 {
+		w := heap.Pop(&s.queue).(*waiter) //nolint:errcheck // Only waiters are queued.
+		s.queueWaitTotal += time.Since(w.enqueuedAt)
+		close(w.ready)
+		return
+	}
+	s.running--
+}
+
+// stats returns a snapshot of the scheduler counters.
+func (s *scheduler) stats() SchedulerStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return SchedulerStats{
+		QueueLength:    s.queue.Len(),
+		Queued:         s.queued,
+		QueueWaitTotal: s.queueWaitTotal,
+	}
+}
+
+// SetMaxConcurrency bounds how many requests the client runs at once;
+// requests beyond the limit queue and are dispatched in priority order (see
+// RequestBuilder.Priority). A non-positive n removes the limit.
+func (c *Client) SetMaxConcurrency(n int) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if n <= 0 {
+		c.scheduler = nil
+		return c
+	}
+	c.scheduler = &scheduler{limit: n}
+	return c
+}
+
+// getScheduler returns the request scheduler under the read lock.
+func (c *Client) getScheduler() *scheduler {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.scheduler
+}
+
+// SchedulerStats returns a snapshot of the request scheduler's queue metrics.
+// The zero value is returned when no concurrency limit is set.
+func (c *Client) SchedulerStats() SchedulerStats {
+	if s := c.getScheduler(); s != nil {
+		return s.stats()
+	}
+	return SchedulerStats{}
+}
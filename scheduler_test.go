@@ -0,0 +1,106 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMaxConcurrencyBoundsParallelism(t *testing.T) {
+	var inFlight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt32(&peak)
+			if current <= observed || atomic.CompareAndSwapInt32(&peak, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL}).SetMaxConcurrency(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get("/").Send(context.Background())
+			if err == nil {
+				resp.Close() //nolint: errcheck
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak, int32(2))
+
+	stats := client.SchedulerStats()
+	assert.Equal(t, 0, stats.QueueLength)
+	assert.Positive(t, stats.Queued)
+	assert.Positive(t, stats.QueueWaitTotal)
+}
+
+func TestSchedulerPriorityOrder(t *testing.T) {
+	s := &scheduler{limit: 1}
+	require.NoError(t, s.acquire(context.Background(), 0))
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i, priority := range []int{1, 5, 3} {
+		wg.Add(1)
+		go func(priority int) {
+			defer wg.Done()
+			if err := s.acquire(context.Background(), priority); err != nil {
+				return
+			}
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+			s.release()
+		}(priority)
+		// Wait for each goroutine to enqueue so ordering is deterministic.
+		for s.stats().QueueLength != i+1 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	s.release()
+	wg.Wait()
+
+	assert.Equal(t, []int{5, 3, 1}, order)
+}
+
+func TestSchedulerContextAbandonment(t *testing.T) {
+	s := &scheduler{limit: 1}
+	require.NoError(t, s.acquire(context.Background(), 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.acquire(ctx, 0)
+	}()
+
+	for s.stats().QueueLength == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	assert.Equal(t, 0, s.stats().QueueLength)
+
+	// The held slot is still usable and releasable.
+	s.release()
+}
@@ -0,0 +1,57 @@
+package requests
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServerTiming is one metric from a Server-Timing response header
+// (RFC draft-ietf-httpbis-server-timing): a name with an optional duration
+// and description declared by the server, e.g. `db;dur=36.4;desc="cache miss"`.
+type ServerTiming struct {
+	Name        string
+	Duration    time.Duration
+	Description string
+}
+
+// ServerTiming parses the response's Server-Timing headers into the metrics
+// the server declared, in header order. Comparing them against Duration shows
+// how client-observed latency splits between the server's own segments and
+// everything in between.
+func (r *Response) ServerTiming() []ServerTiming {
+	var metrics []ServerTiming
+	for _, value := range r.Header().Values("Server-Timing") {
+		metrics = append(metrics, parseServerTiming(value)...)
+	}
+	return metrics
+}
+
+// parseServerTiming parses a single Server-Timing header value, which holds
+// comma-separated metrics with semicolon-separated parameters.
+func parseServerTiming(value string) []ServerTiming {
+	var metrics []ServerTiming
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(entry, ";")
+		name := strings.TrimSpace(parts[0])
+		if name == "" {
+			continue
+		}
+
+		metric := ServerTiming{Name: name}
+		for _, param := range parts[1:] {
+			key, paramValue, _ := strings.Cut(strings.TrimSpace(param), "=")
+			paramValue = strings.Trim(paramValue, `"`)
+			switch strings.ToLower(key) {
+			case "dur":
+				if millis, err := strconv.ParseFloat(paramValue, 64); err == nil {
+					metric.Duration = time.Duration(millis * float64(time.Millisecond))
+				}
+			case "desc":
+				metric.Description = paramValue
+			}
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
@@ -0,0 +1,70 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServerTiming(t *testing.T) {
+	metrics := parseServerTiming(`db;dur=36.4;desc="cache miss", app;dur=12, total`)
+
+	require.Len(t, metrics, 3)
+	assert.Equal(t, ServerTiming{Name: "db", Duration: 36400 * time.Microsecond, Description: "cache miss"}, metrics[0])
+	assert.Equal(t, ServerTiming{Name: "app", Duration: 12 * time.Millisecond}, metrics[1])
+	assert.Equal(t, ServerTiming{Name: "total"}, metrics[2])
+}
+
+func TestResponseDurationAndServerTiming(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Server-Timing", "db;dur=3.5")
+		w.Header().Add("Server-Timing", `edge;desc="cdn"`)
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.GreaterOrEqual(t, resp.Duration(), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, resp.Duration(), resp.LastAttemptDuration())
+	assert.Positive(t, resp.LastAttemptDuration())
+
+	metrics := resp.ServerTiming()
+	require.Len(t, metrics, 2)
+	assert.Equal(t, ServerTiming{Name: "db", Duration: 3500 * time.Microsecond}, metrics[0])
+	assert.Equal(t, ServerTiming{Name: "edge", Description: "cdn"}, metrics[1])
+}
+
+func TestDurationIncludesRetryDelays(t *testing.T) {
+	var calls int
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL, MaxRetries: 1})
+	client.SetRetryStrategy(LinearBackoffStrategy(20 * time.Millisecond))
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, 2, resp.Attempts())
+	assert.GreaterOrEqual(t, resp.Duration(), 20*time.Millisecond)
+	assert.Less(t, resp.LastAttemptDuration(), resp.Duration())
+}
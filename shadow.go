@@ -0,0 +1,85 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// shadowMirror duplicates a sampled share of traffic to a mirror host,
+// discarding the mirror's responses.
+type shadowMirror struct {
+	client  *Client
+	mirror  *url.URL
+	percent float64
+	wg      sync.WaitGroup
+}
+
+// ShadowTraffic installs a middleware that asynchronously duplicates percent
+// (0-100) of requests to the mirror URL, dropping the mirror's responses, so
+// new backends can be tested against production traffic shapes. The original
+// request and response are never consumed or delayed by the mirror; bodies
+// are re-read through GetBody. Close waits for in-flight mirror requests.
+func (c *Client) ShadowTraffic(mirrorURL string, percent float64) error {
+	parsed, err := url.Parse(mirrorURL)
+	if err != nil {
+		return err
+	}
+
+	shadow := &shadowMirror{client: c, mirror: parsed, percent: percent}
+	c.UseNamed("shadow", shadow.middleware)
+	c.addCloseHook(shadow.wg.Wait)
+	return nil
+}
+
+// middleware samples the request, fires the mirror copy on its own goroutine,
+// and passes the original through untouched.
+func (s *shadowMirror) middleware(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if rand.Float64()*100 < s.percent { //nolint:gosec // Sampling, not security.
+			if mirrorReq := s.mirrorRequest(req); mirrorReq != nil {
+				s.wg.Add(1)
+				go s.send(mirrorReq)
+			}
+		}
+		return next(req)
+	}
+}
+
+// mirrorRequest clones the request onto the mirror host with a fresh body, or
+// returns nil if the body cannot be duplicated.
+func (s *shadowMirror) mirrorRequest(req *http.Request) *http.Request {
+	// Detach from the original's cancellation so a fast primary response
+	// does not abort the mirror copy mid-flight.
+	clone := req.Clone(context.WithoutCancel(req.Context()))
+	clone.URL.Scheme = s.mirror.Scheme
+	clone.URL.Host = s.mirror.Host
+	clone.Host = s.mirror.Host
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil
+		}
+		clone.Body = body
+	}
+	return clone
+}
+
+// send executes the mirror request and discards the response.
+func (s *shadowMirror) send(req *http.Request) {
+	defer s.wg.Done()
+
+	resp, err := s.client.getHTTPClient().Do(req)
+	if err != nil {
+		if logger := s.client.getLogger(); logger != nil {
+			logger.Errorf("Shadow request failed: %v", err)
+		}
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
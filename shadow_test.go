@@ -0,0 +1,65 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShadowTraffic(t *testing.T) {
+	var mirrored atomic.Int64
+	var mirrorBody atomic.Value
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mirrorBody.Store(string(body))
+		mirrored.Add(1)
+	}))
+	defer mirror.Close()
+
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer primary.Close()
+
+	client := Create(&Config{BaseURL: primary.URL})
+	require.NoError(t, client.ShadowTraffic(mirror.URL, 100))
+
+	resp, err := client.Post("/echo").Body("payload").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	// The primary response is intact and Close waits for the mirror copy.
+	assert.Equal(t, "payload", resp.String())
+	require.NoError(t, client.Close(context.Background()))
+	assert.Equal(t, int64(1), mirrored.Load())
+	assert.Equal(t, "payload", mirrorBody.Load())
+}
+
+func TestShadowTrafficZeroPercent(t *testing.T) {
+	var mirrored atomic.Int64
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mirrored.Add(1)
+	}))
+	defer mirror.Close()
+
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer primary.Close()
+
+	client := Create(&Config{BaseURL: primary.URL})
+	require.NoError(t, client.ShadowTraffic(mirror.URL, 0))
+
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, resp.Close())
+	}
+	require.NoError(t, client.Close(context.Background()))
+	assert.Equal(t, int64(0), mirrored.Load())
+}
@@ -0,0 +1,90 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestSetSlogLogsRequests(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetSlog(logger)
+
+	resp, err := client.Get("/widgets").Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	output := buf.String()
+	assert.Contains(t, output, "request completed")
+	assert.Contains(t, output, "method=GET")
+	assert.Contains(t, output, "status=200")
+	assert.Contains(t, output, "attempts=1")
+	assert.Contains(t, output, "duration=")
+	assert.Contains(t, output, "category=transport")
+}
+
+func TestSetSlogLogsRetries(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer mockServer.Close()
+
+	var buf bytes.Buffer
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.SetSlog(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	resp, err := client.Get("/").
+		MaxRetries(1).
+		RetryStrategy(func(attempt int) time.Duration { return 0 }).
+		RetryIf(DefaultRetryIf).
+		Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	output := buf.String()
+	assert.Contains(t, output, "retrying request")
+	assert.Contains(t, output, "category=retry")
+
+	// Raising the retry category level silences retry records only.
+	buf.Reset()
+	client.SetLogCategoryLevel(LogCategoryRetry, LevelError)
+	resp, err = client.Get("/").
+		MaxRetries(1).
+		RetryStrategy(func(attempt int) time.Duration { return 0 }).
+		RetryIf(DefaultRetryIf).
+		Send(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, resp.Close())
+
+	output = buf.String()
+	assert.NotContains(t, output, "retrying request")
+	assert.Contains(t, output, "request completed")
+}
+
+func TestSlogAdapterLevels(t *testing.T) {
+	var buf bytes.Buffer
+	adapter := NewSlogAdapter(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	adapter.SetLevel(LevelWarn)
+	adapter.Infof("hidden %s", "info")
+	adapter.Errorf("visible %s", "error")
+
+	output := buf.String()
+	assert.NotContains(t, output, "hidden info")
+	assert.Contains(t, output, "visible error")
+}
@@ -0,0 +1,93 @@
+package requests
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// SetBodySpill makes responses larger than threshold bytes buffer to a
+// temporary file instead of RAM. Scan, Save, String and friends read the
+// spilled body from disk transparently, and Close removes the file, so the
+// occasional huge payload no longer balloons memory. dir is where spill
+// files are created; an empty dir uses the system temp directory. A
+// threshold of zero or less disables spilling. Bodies going through a
+// BodyTransformer are never spilled, since transformers need the full slice.
+func (c *Client) SetBodySpill(threshold int64, dir string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.spillThreshold = threshold
+	c.spillDir = dir
+	return c
+}
+
+// getBodySpill returns the spill threshold and directory under the read lock.
+func (c *Client) getBodySpill() (int64, string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.spillThreshold, c.spillDir
+}
+
+// Spilled reports whether the body was buffered to a temporary file rather
+// than memory.
+func (r *Response) Spilled() bool {
+	return r.spillPath != ""
+}
+
+// spillBody writes the already-read head and the rest of the wire body to a
+// temporary file and points the response at it. The body cap still applies
+// while draining the remainder.
+func (r *Response) spillBody(head []byte, rest io.Reader, maxBody int64, dir string) error {
+	file, err := os.CreateTemp(dir, "requests-body-*")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBodySpillFailed, err) //nolint:errorlint
+	}
+	cleanup := func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}
+
+	if _, err := file.Write(head); err != nil {
+		cleanup()
+		return fmt.Errorf("%w: %v", ErrBodySpillFailed, err) //nolint:errorlint
+	}
+	copied, err := io.Copy(file, rest)
+	if err != nil {
+		cleanup()
+		return fmt.Errorf("%w: %v", ErrResponseReadFailed, err) //nolint:errorlint
+	}
+	total := int64(len(head)) + copied
+	if maxBody > 0 && total > maxBody {
+		cleanup()
+		_ = r.RawResponse.Body.Close()
+		return fmt.Errorf("%w: body exceeds %d bytes", ErrBodyTooLarge, maxBody)
+	}
+	_ = r.RawResponse.Body.Close()
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return fmt.Errorf("%w: %v", ErrBodySpillFailed, err) //nolint:errorlint
+	}
+
+	r.spillPath = file.Name()
+	r.RawResponse.Body = file
+	r.BodyBytes = nil
+	return nil
+}
+
+// bodySource returns a reader over the full body — from disk when spilled,
+// from the in-memory buffer otherwise — plus a cleanup func the caller must
+// run when done reading.
+func (r *Response) bodySource() (io.Reader, func(), error) {
+	if r.spillPath != "" {
+		file, err := os.Open(r.spillPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrBodySpillFailed, err) //nolint:errorlint
+		}
+		return file, func() { _ = file.Close() }, nil
+	}
+	return bytes.NewReader(r.BodyBytes), func() {}, nil
+}
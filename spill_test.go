@@ -0,0 +1,106 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodySpillsPastThreshold(t *testing.T) {
+	payload := strings.Repeat("x", 4096)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(payload))
+	}))
+	defer server.Close()
+
+	spillDir := t.TempDir()
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetBodySpill(1024, spillDir)
+
+	resp, err := client.Get("/big").Send(context.Background())
+	require.NoError(t, err)
+	require.True(t, resp.Spilled())
+	assert.Equal(t, len(payload), resp.ContentLength())
+	assert.Equal(t, payload, resp.String())
+
+	entries, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, resp.Close())
+	entries, err = os.ReadDir(spillDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "spill file should be removed on Close")
+}
+
+func TestBodyBelowThresholdStaysInMemory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("small"))
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetBodySpill(1024, t.TempDir())
+
+	resp, err := client.Get("/small").Send(context.Background())
+	require.NoError(t, err)
+	assert.False(t, resp.Spilled())
+	assert.Equal(t, "small", resp.String())
+}
+
+func TestSpilledBodyScanAndSave(t *testing.T) {
+	body := `{"name":"` + strings.Repeat("a", 2048) + `"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetBodySpill(256, t.TempDir())
+
+	resp, err := client.Get("/doc").Send(context.Background())
+	require.NoError(t, err)
+	require.True(t, resp.Spilled())
+
+	var decoded struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, resp.Scan(&decoded))
+	assert.Len(t, decoded.Name, 2048)
+
+	target := filepath.Join(t.TempDir(), "saved.json")
+	require.NoError(t, resp.Save(target))
+	saved, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(saved))
+
+	var buf bytes.Buffer
+	require.NoError(t, resp.Tee(&buf))
+	assert.Equal(t, body, buf.String())
+
+	require.NoError(t, resp.Close())
+}
+
+func TestSpillRespectsMaxBodyLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(bytes.Repeat([]byte("y"), 8192))
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetBodySpill(512, t.TempDir())
+	client.SetLimits(Limits{MaxBodyBytes: 4096})
+
+	_, err := client.Get("/big").Send(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrBodyTooLarge)
+}
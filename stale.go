@@ -0,0 +1,75 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"syscall"
+)
+
+// StaleConnectionPolicy reports whether an error indicates the request died
+// on a stale pooled connection (e.g. after a server-side GOAWAY storm), in
+// which case the client flushes idle connections and transparently retries
+// the attempt once on a fresh connection.
+type StaleConnectionPolicy func(err error) bool
+
+// DefaultStaleConnectionPolicy matches the transport errors produced by
+// connections the server already tore down: HTTP/2 connection loss and
+// GOAWAY, broken pipes, and peer resets. Caller cancellations never match.
+func DefaultStaleConnectionPolicy(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.EPIPE) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	msg := err.Error()
+	return strings.Contains(msg, "http2: client connection lost") ||
+		strings.Contains(msg, "http2: server sent GOAWAY") ||
+		strings.Contains(msg, "broken pipe") ||
+		strings.Contains(msg, "connection reset by peer")
+}
+
+// SetStaleConnectionPolicy replaces the policy deciding which transport
+// errors trigger the idle-connection flush and transparent retry. The
+// default is DefaultStaleConnectionPolicy; a policy that always returns
+// false disables the behavior.
+func (c *Client) SetStaleConnectionPolicy(policy StaleConnectionPolicy) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.staleConnPolicy = policy
+	return c
+}
+
+// isStaleConnectionError applies the configured policy, falling back to the
+// default when none is set.
+func (c *Client) isStaleConnectionError(err error) bool {
+	c.mu.RLock()
+	policy := c.staleConnPolicy
+	c.mu.RUnlock()
+
+	if policy == nil {
+		policy = DefaultStaleConnectionPolicy
+	}
+	return policy(err)
+}
+
+// retryStaleConnection flushes idle connections and replays the request once
+// when its body can be rewound, returning false when no retry is possible.
+func retryStaleConnection(req *http.Request) bool {
+	if req.Body == nil && req.GetBody == nil {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+	newBody, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = newBody
+	return true
+}
@@ -0,0 +1,72 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultStaleConnectionPolicy(t *testing.T) {
+	assert.True(t, DefaultStaleConnectionPolicy(errors.New("http2: client connection lost")))
+	assert.True(t, DefaultStaleConnectionPolicy(errors.New(`http2: server sent GOAWAY and closed the connection`)))
+	assert.True(t, DefaultStaleConnectionPolicy(errors.New("write tcp 1.2.3.4:80: write: broken pipe")))
+	assert.True(t, DefaultStaleConnectionPolicy(syscall.ECONNRESET))
+
+	assert.False(t, DefaultStaleConnectionPolicy(nil))
+	assert.False(t, DefaultStaleConnectionPolicy(context.Canceled))
+	assert.False(t, DefaultStaleConnectionPolicy(errors.New("no such host")))
+}
+
+// staleOnceTransport fails the first call with a stale-connection error and
+// passes later calls through.
+type staleOnceTransport struct {
+	calls atomic.Int32
+	next  http.RoundTripper
+}
+
+func (t *staleOnceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.calls.Add(1) == 1 {
+		return nil, errors.New("http2: client connection lost")
+	}
+	return t.next.RoundTrip(req)
+}
+
+func TestStaleConnectionTransparentRetry(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("recovered"))
+	}))
+	defer mockServer.Close()
+
+	transport := &staleOnceTransport{next: http.DefaultTransport}
+	client := Create(&Config{BaseURL: mockServer.URL, Transport: transport})
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err, "the stale connection should be retried transparently")
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, "recovered", resp.String())
+	assert.Equal(t, int32(2), transport.calls.Load())
+	assert.Equal(t, 1, resp.Attempts(), "the transparent replay is not a retry-budget attempt")
+}
+
+func TestStaleConnectionPolicyDisable(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	transport := &staleOnceTransport{next: http.DefaultTransport}
+	client := Create(&Config{BaseURL: mockServer.URL, Transport: transport})
+	client.SetStaleConnectionPolicy(func(error) bool { return false })
+
+	_, err := client.Get("/").Send(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, int32(1), transport.calls.Load())
+}
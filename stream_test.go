@@ -44,3 +44,94 @@ func TestStream(t *testing.T) {
 
 	assert.Equal(t, 3, len(dataReceived))
 }
+
+func TestStreamChan(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, "data: Message %d\n", i)
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	builder := client.Get("/")
+	dataCh, errCh := builder.StreamChan()
+
+	_, err := builder.Send(context.Background())
+	assert.NoError(t, err)
+
+	received := make([]string, 0)
+	for chunk := range dataCh {
+		assert.Contains(t, string(chunk), "data: Message")
+		received = append(received, string(chunk))
+	}
+
+	// The error channel closes without a terminal error.
+	streamErr, ok := <-errCh
+	assert.False(t, ok)
+	assert.NoError(t, streamErr)
+	assert.Equal(t, 3, len(received))
+}
+
+func TestStreamIdleTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: first\n")
+		w.(http.Flusher).Flush()
+		// Stall far longer than the idle timeout.
+		time.Sleep(2 * time.Second)
+	}))
+	defer server.Close()
+
+	errCh := make(chan error, 1)
+	doneCh := make(chan struct{})
+
+	client := Create(&Config{BaseURL: server.URL})
+	_, err := client.Get("/").
+		Stream(func(data []byte) error { return nil }).
+		StreamErr(func(err error) { errCh <- err }).
+		StreamDone(func() { close(doneCh) }).
+		StreamIdleTimeout(100 * time.Millisecond).
+		Send(context.Background())
+	assert.NoError(t, err)
+
+	select {
+	case streamErr := <-errCh:
+		assert.ErrorIs(t, streamErr, ErrStreamIdleTimeout)
+	case <-time.After(1 * time.Second):
+		t.Fatal("expected an idle timeout error")
+	}
+	<-doneCh
+}
+
+func TestStreamContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: first\n")
+		w.(http.Flusher).Flush()
+		time.Sleep(2 * time.Second)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneCh := make(chan struct{})
+
+	client := Create(&Config{BaseURL: server.URL})
+	_, err := client.Get("/").
+		Stream(func(data []byte) error { return nil }).
+		StreamDone(func() { close(doneCh) }).
+		Send(ctx)
+	assert.NoError(t, err)
+
+	cancel()
+
+	select {
+	case <-doneCh:
+		// The stream goroutine stopped promptly after cancellation.
+	case <-time.After(1 * time.Second):
+		t.Fatal("stream goroutine did not stop after context cancellation")
+	}
+}
@@ -0,0 +1,60 @@
+package requests
+
+import "context"
+
+// maxStreamResumes caps how many times a dropped stream is re-issued, so a
+// server that keeps dying mid-body cannot trap the client in a resume loop.
+const maxStreamResumes = 10
+
+// StreamResume enables automatic resumption when a streaming request drops
+// mid-stream. On a read error the resume function is called with the number
+// of body bytes already delivered and returns the follow-up request —
+// typically the same URL with a Range header, or Last-Event-ID for SSE. The
+// resumed stream delivers to the same callbacks, and keeps resuming on
+// further drops up to maxStreamResumes times. Returning nil declines the
+// resume and the error reaches StreamErr as usual.
+func (b *RequestBuilder) StreamResume(resume func(lastOffset int64) *RequestBuilder) *RequestBuilder {
+	b.streamResume = resume
+	if b.streamResumesLeft == 0 {
+		b.streamResumesLeft = maxStreamResumes
+	}
+	return b
+}
+
+// resumeStream re-issues a dropped stream from the current offset. It
+// reports whether a resumed stream took over delivery; if not, the caller
+// falls back to the normal error path.
+func (r *Response) resumeStream() bool {
+	if r.streamResume == nil || r.streamResumesLeft <= 0 {
+		return false
+	}
+
+	builder := r.streamResume(r.streamOffset)
+	if builder == nil {
+		return false
+	}
+
+	// The resumed request inherits the original callbacks and resume
+	// settings unless the resume function replaced them.
+	if builder.stream == nil {
+		builder.stream = r.stream
+	}
+	if builder.streamErr == nil {
+		builder.streamErr = r.streamErr
+	}
+	if builder.streamDone == nil {
+		builder.streamDone = r.streamDone
+	}
+	if builder.streamResume == nil {
+		builder.streamResume = r.streamResume
+	}
+	builder.streamResumeFrom = r.streamOffset
+	builder.streamResumesLeft = r.streamResumesLeft - 1
+
+	ctx := r.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, err := builder.Send(ctx)
+	return err == nil
+}
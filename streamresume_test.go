@@ -0,0 +1,117 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyStreamServer serves a payload but drops the connection after half the
+// bytes on the first request; later requests honor Range offsets.
+func flakyStreamServer(t *testing.T, payload string) *httptest.Server {
+	t.Helper()
+	var requests int
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		first := requests == 1
+		mu.Unlock()
+
+		offset := 0
+		if rangeHeader := r.Header.Get("Range"); rangeHeader != "" {
+			fmt.Sscanf(rangeHeader, "bytes=%d-", &offset)
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		remaining := payload[offset:]
+
+		if first {
+			// Advertise the full length but send only half, so the client
+			// sees an unexpected EOF mid-stream.
+			w.Header().Set("Content-Length", strconv.Itoa(len(remaining)))
+			_, _ = w.Write([]byte(remaining[:len(remaining)/2]))
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			_ = conn.Close()
+			return
+		}
+		_, _ = w.Write([]byte(remaining))
+	}))
+}
+
+func TestStreamResumeContinuesAfterDrop(t *testing.T) {
+	payload := strings.Repeat("0123456789", 100)
+	server := flakyStreamServer(t, payload)
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	var received bytes.Buffer
+	var mu sync.Mutex
+	done := make(chan struct{})
+
+	_, err := client.Get("/file").
+		Stream(func(chunk []byte) error {
+			mu.Lock()
+			received.Write(chunk)
+			mu.Unlock()
+			return nil
+		}).
+		StreamErr(func(err error) {
+			t.Errorf("unexpected stream error: %v", err)
+		}).
+		StreamDone(func() {
+			close(done)
+		}).
+		StreamResume(func(lastOffset int64) *RequestBuilder {
+			return client.Get("/file").Header("Range", fmt.Sprintf("bytes=%d-", lastOffset))
+		}).
+		Send(context.Background())
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not complete")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, payload, received.String())
+}
+
+func TestStreamResumeDeclinedFallsBackToStreamErr(t *testing.T) {
+	payload := strings.Repeat("abcdefgh", 64)
+	server := flakyStreamServer(t, payload)
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	errs := make(chan error, 1)
+	_, err := client.Get("/file").
+		Stream(func([]byte) error { return nil }).
+		StreamErr(func(err error) { errs <- err }).
+		StreamResume(func(int64) *RequestBuilder { return nil }).
+		Send(context.Background())
+	require.NoError(t, err)
+
+	select {
+	case streamErr := <-errs:
+		require.Error(t, streamErr)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream error callback was not invoked")
+	}
+}
@@ -0,0 +1,77 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Tag attaches a metadata key/value to the request. Tags never touch the wire
+// request: they ride the request context, so middleware, hooks and custom
+// metrics can read them with TagsFromContext, they are emitted as structured
+// log attributes, and they are written into error artifacts. This lets calls
+// be attributed to a feature or tenant without parsing URLs.
+func (b *RequestBuilder) Tag(key, value string) *RequestBuilder {
+	if b.tags == nil {
+		b.tags = make(map[string]string)
+	}
+	b.tags[key] = value
+	return b
+}
+
+// Tags attaches several metadata key/values at once. See Tag.
+func (b *RequestBuilder) Tags(tags map[string]string) *RequestBuilder {
+	for key, value := range tags {
+		b.Tag(key, value)
+	}
+	return b
+}
+
+// tagsContextKey keys the request tags stored on the request context.
+type tagsContextKey struct{}
+
+// WithTags returns a context carrying the given request tags, merged over any
+// tags already present.
+func WithTags(ctx context.Context, tags map[string]string) context.Context {
+	if len(tags) == 0 {
+		return ctx
+	}
+	merged := make(map[string]string, len(tags))
+	for key, value := range TagsFromContext(ctx) {
+		merged[key] = value
+	}
+	for key, value := range tags {
+		merged[key] = value
+	}
+	return context.WithValue(ctx, tagsContextKey{}, merged)
+}
+
+// TagsFromContext returns the request tags carried by the context, or nil
+// when there are none. The returned map must not be mutated.
+func TagsFromContext(ctx context.Context) map[string]string {
+	tags, _ := ctx.Value(tagsContextKey{}).(map[string]string)
+	return tags
+}
+
+// withTags puts the builder's tags on the context for the rest of the call.
+func (b *RequestBuilder) withTags(ctx context.Context) context.Context {
+	return WithTags(ctx, b.tags)
+}
+
+// sortedTagKeys returns the tag keys in sorted order for stable output.
+func sortedTagKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeArtifactTags writes the request tags into an error artifact dump.
+func writeArtifactTags(dump *strings.Builder, tags map[string]string) {
+	for _, key := range sortedTagKeys(tags) {
+		fmt.Fprintf(dump, "tag %s: %s\n", key, tags[key])
+	}
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagsVisibleToMiddleware(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	var seen map[string]string
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.AddMiddleware(func(next MiddlewareHandlerFunc) MiddlewareHandlerFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			seen = TagsFromContext(req.Context())
+			return next(req)
+		}
+	})
+
+	resp, err := client.Get("/").
+		Tag("feature", "checkout").
+		Tags(map[string]string{"tenant": "acme"}).
+		Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, map[string]string{"feature": "checkout", "tenant": "acme"}, seen)
+}
+
+func TestTagsDoNotTouchWireRequest(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://localhost"})
+
+	req, err := client.Get("/").Tag("feature", "checkout").Build(context.Background())
+	require.NoError(t, err)
+
+	assert.Empty(t, req.Header.Get("feature"))
+	assert.NotContains(t, req.URL.String(), "checkout")
+	assert.Equal(t, map[string]string{"feature": "checkout"}, TagsFromContext(req.Context()))
+}
+
+func TestWithTagsMerges(t *testing.T) {
+	ctx := WithTags(context.Background(), map[string]string{"a": "1", "b": "1"})
+	ctx = WithTags(ctx, map[string]string{"b": "2"})
+
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, TagsFromContext(ctx))
+	assert.Nil(t, TagsFromContext(context.Background()))
+}
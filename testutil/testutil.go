@@ -0,0 +1,170 @@
+// Package testutil provides test servers and handlers for exercising code
+// built on the requests client: TLS servers with generated certificates,
+// slow and flaky handlers, and SSE/chunked response fixtures.
+package testutil
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+// GenerateCert returns a PEM-encoded self-signed certificate and key valid
+// for the given hosts (DNS names or IP addresses) for one hour.
+func GenerateCert(hosts ...string) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"testutil"}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var certBuf, keyBuf bytes.Buffer
+	if err := pem.Encode(&certBuf, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return nil, nil, err
+	}
+	if err := pem.Encode(&keyBuf, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}); err != nil {
+		return nil, nil, err
+	}
+	return certBuf.Bytes(), keyBuf.Bytes(), nil
+}
+
+// NewTLSServer starts an HTTPS test server with a freshly generated
+// certificate for 127.0.0.1. The caller must Close the returned server.
+func NewTLSServer(handler http.Handler) (*httptest.Server, error) {
+	certPEM, keyPEM, err := GenerateCert("127.0.0.1", "localhost")
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}} //nolint:gosec // Test server.
+	server.StartTLS()
+	return server, nil
+}
+
+// ClientTLSConfig returns a TLS config that trusts the server's certificate,
+// for pointing a client at a server started with NewTLSServer.
+func ClientTLSConfig(server *httptest.Server) *tls.Config {
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	return &tls.Config{RootCAs: pool} //nolint:gosec // Test client.
+}
+
+// SlowHandler delays each request by d before invoking next.
+func SlowHandler(d time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(d):
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// FlakyHandler fails the first failures requests with the given status code,
+// then invokes next for the rest. It is safe for concurrent use.
+func FlakyHandler(failures, status int, next http.Handler) http.Handler {
+	var mu sync.Mutex
+	remaining := failures
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fail := remaining > 0
+		if fail {
+			remaining--
+		}
+		mu.Unlock()
+
+		if fail {
+			w.WriteHeader(status)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SSEHandler serves the given payloads as a text/event-stream, one "data:"
+// event per payload, flushing after each and pausing interval between them.
+func SSEHandler(interval time.Duration, payloads ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		flusher, _ := w.(http.Flusher)
+		for i, payload := range payloads {
+			if i > 0 && interval > 0 {
+				select {
+				case <-r.Context().Done():
+					return
+				case <-time.After(interval):
+				}
+			}
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	})
+}
+
+// ChunkedHandler writes each chunk with a flush in between, pausing interval
+// between chunks, to exercise streaming consumers.
+func ChunkedHandler(interval time.Duration, chunks ...[]byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, _ := w.(http.Flusher)
+		for i, chunk := range chunks {
+			if i > 0 && interval > 0 {
+				select {
+				case <-r.Context().Done():
+					return
+				case <-time.After(interval):
+				}
+			}
+			_, _ = w.Write(chunk)
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package testutil
+
+import (
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTLSServer(t *testing.T) {
+	server, err := NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("secure"))
+	}))
+	require.NoError(t, err)
+	defer server.Close()
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: ClientTLSConfig(server)}}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close() //nolint: errcheck
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "secure", string(body))
+	assert.True(t, resp.TLS.HandshakeComplete)
+}
+
+func TestGenerateCert(t *testing.T) {
+	certPEM, keyPEM, err := GenerateCert("example.test", "10.0.0.1")
+	require.NoError(t, err)
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	assert.NotEmpty(t, cert.Certificate)
+}
+
+func TestFlakyHandler(t *testing.T) {
+	handler := FlakyHandler(2, http.StatusServiceUnavailable, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	statuses := make([]int, 0, 3)
+	for i := 0; i < 3; i++ {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, &http.Request{})
+		statuses = append(statuses, recorder.Code)
+	}
+	assert.Equal(t, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, statuses)
+}
+
+func TestSlowHandler(t *testing.T) {
+	handler := SlowHandler(50*time.Millisecond, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	start := time.Now()
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	handler.ServeHTTP(recorder, req)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
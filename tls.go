@@ -0,0 +1,157 @@
+package requests
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// defaultSessionCacheCapacity is the LRU capacity used when session
+// resumption is enabled without a custom cache.
+const defaultSessionCacheCapacity = 64
+
+// applyTLSConfigLocked mirrors the client TLS config onto the underlying
+// transport. Callers must hold the write lock.
+func (c *Client) applyTLSConfigLocked() {
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{}
+	}
+	if transport, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+		transport.TLSClientConfig = c.TLSConfig
+	} else {
+		c.HTTPClient.Transport = &http.Transport{
+			TLSClientConfig: c.TLSConfig,
+		}
+	}
+}
+
+// ensureTLSConfigLocked returns the client TLS config, creating it when
+// unset. Callers must hold the write lock.
+func (c *Client) ensureTLSConfigLocked() *tls.Config {
+	if c.TLSConfig == nil {
+		c.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return c.TLSConfig
+}
+
+// SetTLSSessionCache installs a ClientSessionCache so TLS sessions are resumed
+// across connections, e.g. tls.NewLRUClientSessionCache or a FileSessionCache.
+func (c *Client) SetTLSSessionCache(cache tls.ClientSessionCache) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	config := c.ensureTLSConfigLocked()
+	config.ClientSessionCache = cache
+	config.SessionTicketsDisabled = cache == nil
+	c.applyTLSConfigLocked()
+	return c
+}
+
+// SetTLSSessionResumption toggles session ticket/PSK resumption. Enabling it
+// installs an in-memory LRU session cache when none is configured; disabling
+// it drops the cache and turns session tickets off.
+func (c *Client) SetTLSSessionResumption(enabled bool) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	config := c.ensureTLSConfigLocked()
+	if enabled {
+		if config.ClientSessionCache == nil {
+			config.ClientSessionCache = tls.NewLRUClientSessionCache(defaultSessionCacheCapacity)
+		}
+		config.SessionTicketsDisabled = false
+	} else {
+		config.ClientSessionCache = nil
+		config.SessionTicketsDisabled = true
+	}
+	c.applyTLSConfigLocked()
+	return c
+}
+
+// FileSessionCache is a tls.ClientSessionCache that persists sessions to disk
+// so TLS resumption survives process restarts.
+type FileSessionCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileSessionCache creates a session cache rooted at the given directory,
+// creating it when necessary.
+func NewFileSessionCache(dir string) (*FileSessionCache, error) {
+	if err := os.MkdirAll(dir, dirPermissions); err != nil {
+		return nil, err
+	}
+	return &FileSessionCache{dir: dir}, nil
+}
+
+// persistedSession is the on-disk form of a resumable TLS session.
+type persistedSession struct {
+	Ticket []byte `json:"ticket"`
+	State  []byte `json:"state"`
+}
+
+// path maps a session key to a file name, hashing to keep host:port keys
+// filesystem-safe.
+func (f *FileSessionCache) path(sessionKey string) string {
+	sum := sha256.Sum256([]byte(sessionKey))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:16])+".session")
+}
+
+// Put persists the session for the given key, replacing any previous one.
+func (f *FileSessionCache) Put(sessionKey string, cs *tls.ClientSessionState) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if cs == nil {
+		_ = os.Remove(f.path(sessionKey))
+		return
+	}
+
+	ticket, state, err := cs.ResumptionState()
+	if err != nil || state == nil {
+		return
+	}
+	stateBytes, err := state.Bytes()
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(persistedSession{Ticket: ticket, State: stateBytes})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(f.path(sessionKey), data, 0o600)
+}
+
+// Get loads the persisted session for the given key, if any.
+func (f *FileSessionCache) Get(sessionKey string) (*tls.ClientSessionState, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	data, err := os.ReadFile(f.path(sessionKey))
+	if err != nil {
+		return nil, false
+	}
+
+	var persisted persistedSession
+	if err := json.Unmarshal(data, &persisted); err != nil {
+		return nil, false
+	}
+
+	state, err := tls.ParseSessionState(persisted.State)
+	if err != nil {
+		return nil, false
+	}
+	cs, err := tls.NewResumptionState(persisted.Ticket, state)
+	if err != nil {
+		return nil, false
+	}
+	return cs, true
+}
@@ -0,0 +1,80 @@
+package requests
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTLSSessionResumption(t *testing.T) {
+	client := Create(&Config{})
+	client.SetTLSSessionResumption(true)
+
+	require.NotNil(t, client.TLSConfig.ClientSessionCache)
+	assert.False(t, client.TLSConfig.SessionTicketsDisabled)
+
+	client.SetTLSSessionResumption(false)
+	assert.Nil(t, client.TLSConfig.ClientSessionCache)
+	assert.True(t, client.TLSConfig.SessionTicketsDisabled)
+
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, client.TLSConfig, transport.TLSClientConfig)
+}
+
+func TestSetTLSSessionCache(t *testing.T) {
+	cache := tls.NewLRUClientSessionCache(8)
+
+	client := Create(&Config{})
+	client.SetTLSSessionCache(cache)
+
+	assert.Equal(t, cache, client.TLSConfig.ClientSessionCache)
+	assert.False(t, client.TLSConfig.SessionTicketsDisabled)
+}
+
+func TestFileSessionCacheRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	cache, err := NewFileSessionCache(dir)
+	require.NoError(t, err)
+
+	// Unknown keys miss without error.
+	_, ok := cache.Get("example.com:443")
+	assert.False(t, ok)
+
+	// A nil Put removes any persisted session.
+	cache.Put("example.com:443", nil)
+	_, ok = cache.Get("example.com:443")
+	assert.False(t, ok)
+}
+
+func TestTLSSessionResumedOnResponse(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+	client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS12}) //nolint:gosec // Test server certificate.
+	client.SetTLSSessionResumption(true)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+
+	// The first handshake cannot resume a session.
+	assert.False(t, resp.TLSSessionResumed())
+
+	// Force a fresh connection so the second call performs a new handshake.
+	client.CloseIdleConnections()
+
+	resp, err = client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.True(t, resp.TLSSessionResumed())
+}
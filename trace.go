@@ -0,0 +1,83 @@
+package requests
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+)
+
+// requestPhase identifies how far a request progressed through the transport,
+// recorded via httptrace so failures can be attributed to the right phase.
+type requestPhase int32
+
+const (
+	phaseInit requestPhase = iota
+	phaseDNS
+	phaseConnect
+	phaseTLSHandshake
+	phaseAwaitingHeaders
+	phaseBody
+)
+
+// phaseTracker records the most recent transport phase a request entered.
+type phaseTracker struct {
+	phase atomic.Int32
+}
+
+func (t *phaseTracker) enter(phase requestPhase) {
+	t.phase.Store(int32(phase))
+}
+
+func (t *phaseTracker) current() requestPhase {
+	return requestPhase(t.phase.Load())
+}
+
+// classifyTransportError wraps transport failures in a phase-specific
+// sentinel (ErrDNS, ErrConnect, ErrTLSHandshake, ErrResponseHeaderTimeout,
+// ErrBodyReadTimeout) so callers can choose fallback strategies per failure
+// phase. The original error stays in the chain; unrecognized errors are
+// returned unchanged.
+func classifyTransportError(phase requestPhase, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return fmt.Errorf("%w: %w", ErrDNS, err)
+	}
+
+	var recordErr tls.RecordHeaderError
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &recordErr) || errors.As(err, &certErr) {
+		return fmt.Errorf("%w: %w", ErrTLSHandshake, err)
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return fmt.Errorf("%w: %w", ErrConnect, err)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		switch phase {
+		case phaseDNS:
+			return fmt.Errorf("%w: %w", ErrDNS, err)
+		case phaseConnect:
+			return fmt.Errorf("%w: %w", ErrConnect, err)
+		case phaseTLSHandshake:
+			return fmt.Errorf("%w: %w", ErrTLSHandshake, err)
+		case phaseAwaitingHeaders:
+			return fmt.Errorf("%w: %w", ErrResponseHeaderTimeout, err)
+		case phaseBody:
+			return fmt.Errorf("%w: %w", ErrBodyReadTimeout, err)
+		}
+	}
+
+	if phase == phaseTLSHandshake {
+		return fmt.Errorf("%w: %w", ErrTLSHandshake, err)
+	}
+	return err
+}
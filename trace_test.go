@@ -0,0 +1,56 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timeoutError is a minimal net.Error whose Timeout reports true.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyTransportErrorByType(t *testing.T) {
+	dnsErr := &net.DNSError{Err: "no such host", Name: "example.invalid"}
+	assert.ErrorIs(t, classifyTransportError(phaseDNS, dnsErr), ErrDNS)
+
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	assert.ErrorIs(t, classifyTransportError(phaseConnect, dialErr), ErrConnect)
+}
+
+func TestClassifyTransportErrorByTimeoutPhase(t *testing.T) {
+	var err timeoutError
+	assert.ErrorIs(t, classifyTransportError(phaseAwaitingHeaders, err), ErrResponseHeaderTimeout)
+	assert.ErrorIs(t, classifyTransportError(phaseBody, err), ErrBodyReadTimeout)
+	assert.ErrorIs(t, classifyTransportError(phaseTLSHandshake, err), ErrTLSHandshake)
+}
+
+func TestClassifyTransportErrorPreservesChain(t *testing.T) {
+	inner := &net.DNSError{Err: "no such host", Name: "example.invalid"}
+	classified := classifyTransportError(phaseDNS, inner)
+
+	var dnsErr *net.DNSError
+	require.ErrorAs(t, classified, &dnsErr)
+	assert.Equal(t, "example.invalid", dnsErr.Name)
+}
+
+func TestClassifyTransportErrorPassthrough(t *testing.T) {
+	plain := errors.New("application failure")
+	assert.Equal(t, plain, classifyTransportError(phaseBody, plain))
+	assert.NoError(t, classifyTransportError(phaseInit, nil))
+}
+
+func TestSendClassifiesConnectFailure(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://127.0.0.1:1"})
+
+	_, err := client.Get("/").Send(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConnect)
+}
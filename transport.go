@@ -0,0 +1,213 @@
+package requests
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// IPFamily selects which address families the client dialer may use.
+type IPFamily int
+
+// The address family modes accepted by SetIPFamily.
+const (
+	DualStack IPFamily = iota // Both IPv4 and IPv6, with Happy Eyeballs fallback
+	IPv4Only                  // Dial IPv4 addresses only
+	IPv6Only                  // Dial IPv6 addresses only
+)
+
+// Dialer defaults matching http.DefaultTransport.
+const (
+	defaultDialTimeout   = 30 * time.Second
+	defaultDialKeepAlive = 30 * time.Second
+)
+
+// SetIPFamily restricts the address family used when dialing, so clients on
+// networks with broken IPv6 (or IPv4) can pin connections to the working one.
+func (c *Client) SetIPFamily(family IPFamily) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ipFamily = family
+	c.applyDialerLocked()
+	return c
+}
+
+// SetFallbackDelay tunes the Happy Eyeballs fallback delay: how long to wait
+// for the preferred address family before also trying the other one. Zero
+// uses the net package default and a negative value disables dual-stack
+// fallback entirely.
+func (c *Client) SetFallbackDelay(delay time.Duration) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fallbackDelay = delay
+	c.applyDialerLocked()
+	return c
+}
+
+// applyDialerLocked installs a DialContext honoring the configured address
+// family and fallback delay on the underlying transport. Callers must hold
+// the write lock.
+func (c *Client) applyDialerLocked() {
+	family := c.ipFamily
+	dialer := &net.Dialer{
+		Timeout:       defaultDialTimeout,
+		KeepAlive:     defaultDialKeepAlive,
+		FallbackDelay: c.fallbackDelay,
+	}
+
+	dialContext := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		switch family {
+		case IPv4Only:
+			network = "tcp4"
+		case IPv6Only:
+			network = "tcp6"
+		case DualStack:
+		}
+		return dialer.DialContext(ctx, network, addr)
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{}
+	}
+	if transport, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+		transport.DialContext = dialContext
+	} else {
+		c.HTTPClient.Transport = &http.Transport{
+			DialContext:     dialContext,
+			TLSClientConfig: c.TLSConfig,
+		}
+	}
+}
+
+// PoolStats is a snapshot of connection pool activity observed through
+// httptrace bookkeeping on requests sent by the client.
+type PoolStats struct {
+	InFlight    int            // Requests currently in flight
+	OpenedConns int            // Connections dialed since the client was created
+	ReusedConns int            // Connections obtained from the idle pool
+	PerHost     map[string]int // In-flight requests per host
+}
+
+// poolMetrics aggregates connection events across requests.
+type poolMetrics struct {
+	mu          sync.Mutex
+	inFlight    int
+	openedConns int
+	reusedConns int
+	perHost     map[string]int
+}
+
+// requestStarted records a request against the given host and returns a
+// context wired with the httptrace hooks that track connection usage.
+func (m *poolMetrics) requestStarted(ctx context.Context, host string) context.Context {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inFlight++
+	if m.perHost == nil {
+		m.perHost = make(map[string]int)
+	}
+	m.perHost[host]++
+
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+
+			if info.Reused {
+				m.reusedConns++
+			} else {
+				m.openedConns++
+			}
+		},
+	})
+}
+
+// requestFinished records the completion of a request against the given host.
+func (m *poolMetrics) requestFinished(host string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inFlight--
+	if m.perHost != nil {
+		m.perHost[host]--
+		if m.perHost[host] <= 0 {
+			delete(m.perHost, host)
+		}
+	}
+}
+
+// snapshot returns a copy of the current counters.
+func (m *poolMetrics) snapshot() PoolStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	perHost := make(map[string]int, len(m.perHost))
+	for host, count := range m.perHost {
+		perHost[host] = count
+	}
+
+	return PoolStats{
+		InFlight:    m.inFlight,
+		OpenedConns: m.openedConns,
+		ReusedConns: m.reusedConns,
+		PerHost:     perHost,
+	}
+}
+
+// PoolStats returns a snapshot of the connection pool activity for requests
+// sent through this client.
+func (c *Client) PoolStats() PoolStats {
+	return c.metrics.snapshot()
+}
+
+// CloseIdleConnections closes idle connections held by the underlying transport.
+func (c *Client) CloseIdleConnections() {
+	c.getHTTPClient().CloseIdleConnections()
+}
+
+// Preconnect warms up to n connections to the given host by issuing
+// concurrent HEAD requests, leaving the connections in the idle pool for
+// latency-sensitive callers. Hosts without a scheme default to https.
+func (c *Client) Preconnect(host string, n int) error {
+	target := host
+	if parsed, err := url.Parse(host); err != nil || parsed.Scheme == "" {
+		target = "https://" + host
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodHead, target, nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			resp, err := c.getHTTPClient().Do(req)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			_ = resp.Body.Close()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{BaseURL: server.URL})
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get("/").Send(context.Background())
+		if err != nil {
+			t.Fatalf("Failed to send request: %v", err)
+		}
+		resp.Close() //nolint: errcheck
+	}
+
+	stats := client.PoolStats()
+	assert.Equal(t, 0, stats.InFlight)
+	assert.Empty(t, stats.PerHost)
+	assert.Equal(t, 3, stats.OpenedConns+stats.ReusedConns)
+	assert.GreaterOrEqual(t, stats.OpenedConns, 1)
+}
+
+func TestPreconnect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := Create(&Config{})
+	assert.NoError(t, client.Preconnect(server.URL, 2))
+
+	// Idle connections can be released explicitly.
+	client.CloseIdleConnections()
+}
+
+func TestSetIPFamily(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The httptest server listens on 127.0.0.1, so IPv4-only succeeds.
+	client := Create(&Config{BaseURL: server.URL}).SetIPFamily(IPv4Only)
+
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint: errcheck
+	assert.True(t, resp.IsSuccess())
+
+	// IPv6-only cannot reach an IPv4 listener.
+	client = Create(&Config{BaseURL: server.URL}).SetIPFamily(IPv6Only)
+	_, err = client.Get("/").Send(context.Background())
+	assert.Error(t, err)
+}
+
+func TestSetFallbackDelay(t *testing.T) {
+	client := Create(&Config{}).SetFallbackDelay(-1)
+
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.NotNil(t, transport.DialContext)
+}
@@ -0,0 +1,65 @@
+package requests
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Validate checks the builder for conflicting or broken configuration before
+// the request is sent: an invalid method, a body alongside form fields, and
+// path placeholders with no matching param. All problems are aggregated into
+// one error. A body on a GET or HEAD request is legal but unusual, so it only
+// logs a warning. Send runs the same validation automatically.
+func (b *RequestBuilder) Validate() error {
+	// Errors accumulated by client setters and builder methods surface here
+	// first.
+	var errs []error
+	if err := b.client.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, b.errs...)
+
+	if !isValidMethod(b.method) {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidMethod, b.method))
+	}
+
+	if b.bodyData != nil && (len(b.formFields) > 0 || len(b.formFiles) > 0) {
+		errs = append(errs, fmt.Errorf("%w: both form fields and a body are set", ErrConflictingBody))
+	}
+	if b.bodyData != nil && len(b.relatedParts) > 0 {
+		errs = append(errs, fmt.Errorf("%w: both related parts and a body are set", ErrConflictingBody))
+	}
+
+	if _, err := b.preparePath(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if b.bodyData != nil && (b.method == http.MethodGet || b.method == http.MethodHead) && !b.allowGetBody {
+		if b.client.rejectsGetBody() {
+			errs = append(errs, fmt.Errorf("%w: body set on %s %s", ErrBodyNotAllowed, b.method, b.path))
+		} else if logger := b.client.getLogger(); logger != nil {
+			logger.Warnf("Request body set on %s %s; many servers ignore it", b.method, b.path)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// AllowGetBody declares that a body on this GET or HEAD request is
+// intentional — Elasticsearch-style search APIs expect one — silencing the
+// warning and bypassing SetRejectGetBody.
+func (b *RequestBuilder) AllowGetBody() *RequestBuilder {
+	b.allowGetBody = true
+	return b
+}
+
+// isValidMethod reports whether the method is a non-empty HTTP token,
+// allowing custom methods alongside the standard ones.
+func isValidMethod(method string) bool {
+	if method == "" {
+		return false
+	}
+	return !strings.ContainsAny(method, " \t\r\n\"(),/:;<=>?@[]\\{}")
+}
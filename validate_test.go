@@ -0,0 +1,81 @@
+package requests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePassesCleanBuilder(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	assert.NoError(t, client.Post("/users").JSONBody(map[string]string{"name": "alice"}).Validate())
+}
+
+func TestValidateRejectsInvalidMethod(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	err := client.Custom("/users", "BAD METHOD").Validate()
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+}
+
+func TestValidateRejectsConflictingBody(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	err := client.Post("/users").
+		JSONBody(map[string]string{"name": "alice"}).
+		FormFields(map[string]string{"name": "bob"}).
+		Validate()
+	assert.ErrorIs(t, err, ErrConflictingBody)
+}
+
+func TestValidateRejectsMissingPathParam(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	err := client.Get("/users/{id}").PathParams(map[string]string{"other": "1"}).Validate()
+	assert.ErrorIs(t, err, ErrMissingPathParam)
+}
+
+func TestValidateAggregatesErrors(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	err := client.Custom("/users/{id}", "BAD METHOD").
+		JSONBody(map[string]string{"name": "alice"}).
+		FormFields(map[string]string{"name": "bob"}).
+		PathParams(map[string]string{"other": "1"}).
+		Validate()
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+	assert.ErrorIs(t, err, ErrConflictingBody)
+	assert.ErrorIs(t, err, ErrMissingPathParam)
+}
+
+func TestValidateRejectGetBody(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	client.SetRejectGetBody(true)
+
+	err := client.Get("/search").JSONBody(map[string]string{"query": "x"}).Validate()
+	assert.ErrorIs(t, err, ErrBodyNotAllowed)
+
+	err = client.Get("/search").JSONBody(map[string]string{"query": "x"}).AllowGetBody().Validate()
+	assert.NoError(t, err)
+}
+
+func TestNoContentTypeWithoutBody(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	client.SetDefaultHeader("Content-Type", "application/json")
+
+	req, err := client.Get("/users").Build(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, req.Header.Get("Content-Type"), "no body, no Content-Type")
+
+	req, err = client.Post("/users").JSONBody(map[string]string{"name": "alice"}).Build(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+}
+
+func TestSendRunsValidation(t *testing.T) {
+	client := Create(&Config{BaseURL: "http://example.com"})
+	_, err := client.Post("/users").
+		JSONBody(map[string]string{"name": "alice"}).
+		FormFields(map[string]string{"name": "bob"}).
+		Send(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConflictingBody)
+}
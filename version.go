@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"net/http"
+	"runtime"
+	"strings"
+)
+
+// Version is the library version, exposed so callers composing their own
+// User-Agent strings can include it.
+const Version = "1.0.0"
+
+// defaultUserAgent is applied when no User-Agent is configured anywhere,
+// e.g. "kaptinlin-requests/1.0.0 Go/1.22.1".
+var defaultUserAgent = "kaptinlin-requests/" + Version + " Go/" + strings.TrimPrefix(runtime.Version(), "go")
+
+// DisableDefaultUserAgent stops the client from applying the library's
+// default User-Agent, falling back to Go's own default instead.
+func (c *Client) DisableDefaultUserAgent() *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.noDefaultUserAgent = true
+	return c
+}
+
+// applyDefaultUserAgent sets the library User-Agent when none was set by the
+// request, the client defaults, a rotation, or a header profile.
+func (c *Client) applyDefaultUserAgent(header http.Header) {
+	c.mu.RLock()
+	disabled := c.noDefaultUserAgent
+	c.mu.RUnlock()
+
+	if disabled {
+		return
+	}
+	if header.Get("User-Agent") == "" {
+		header.Set("User-Agent", defaultUserAgent)
+	}
+}
@@ -0,0 +1,61 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func userAgentServer(t *testing.T, got *string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*got = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestDefaultUserAgentApplied(t *testing.T) {
+	var got string
+	mockServer := userAgentServer(t, &got)
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, defaultUserAgent, got)
+	assert.Contains(t, got, "kaptinlin-requests/"+Version)
+}
+
+func TestDefaultUserAgentNotOverridingExplicit(t *testing.T) {
+	var got string
+	mockServer := userAgentServer(t, &got)
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	resp, err := client.Get("/").Header("User-Agent", "custom-agent/2.0").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.Equal(t, "custom-agent/2.0", got)
+}
+
+func TestDisableDefaultUserAgent(t *testing.T) {
+	var got string
+	mockServer := userAgentServer(t, &got)
+	defer mockServer.Close()
+
+	client := Create(&Config{BaseURL: mockServer.URL})
+	client.DisableDefaultUserAgent()
+	resp, err := client.Get("/").Send(context.Background())
+	require.NoError(t, err)
+	defer resp.Close() //nolint:errcheck
+
+	assert.NotEqual(t, defaultUserAgent, got)
+	assert.Contains(t, got, "Go-http-client", "Go's own default applies when disabled")
+}
@@ -0,0 +1,163 @@
+// Package webhook sends signed webhook deliveries with standard-webhooks
+// style signatures, idempotency keys, and retry semantics, built on the
+// requests client.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	mathrand "math/rand"
+	"strconv"
+	"time"
+
+	"github.com/kaptinlin/requests"
+)
+
+// Defaults applied when the config leaves retry settings unset.
+const (
+	defaultMaxRetries      = 3
+	defaultInitialInterval = time.Second
+	defaultMaxInterval     = 30 * time.Second
+)
+
+// Delivery describes one webhook delivery attempt set.
+type Delivery struct {
+	ID        string    // Unique delivery ID, also sent as the idempotency key
+	URL       string    // Destination endpoint
+	Timestamp time.Time // Signing timestamp
+	Payload   []byte    // The JSON payload as signed and sent
+}
+
+// DeadLetterFunc is called when a delivery fails all attempts.
+type DeadLetterFunc func(delivery *Delivery, err error)
+
+// Config configures a webhook Sender.
+type Config struct {
+	Client        *requests.Client         // Client used for deliveries; a default one is created when nil
+	Secret        string                   // HMAC secret for standard-webhooks signatures
+	MaxRetries    int                      // Retry attempts per delivery; defaults to 3
+	RetryStrategy requests.BackoffStrategy // Backoff between attempts; defaults to exponential with jitter
+	DeadLetter    DeadLetterFunc           // Called when all attempts fail
+}
+
+// Sender delivers signed webhook payloads.
+type Sender struct {
+	client     *requests.Client
+	secret     []byte
+	maxRetries int
+	strategy   requests.BackoffStrategy
+	deadLetter DeadLetterFunc
+}
+
+// NewSender creates a Sender from the config, filling in defaults.
+func NewSender(config *Config) *Sender {
+	client := config.Client
+	if client == nil {
+		client = requests.Create(&requests.Config{})
+	}
+
+	maxRetries := config.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	strategy := config.RetryStrategy
+	if strategy == nil {
+		strategy = ExponentialJitterBackoff(defaultInitialInterval, defaultMaxInterval)
+	}
+
+	return &Sender{
+		client:     client,
+		secret:     []byte(config.Secret),
+		maxRetries: maxRetries,
+		strategy:   strategy,
+		deadLetter: config.DeadLetter,
+	}
+}
+
+// ExponentialJitterBackoff doubles the delay each attempt up to maxInterval,
+// with up to 50% random jitter to spread retries from concurrent senders.
+func ExponentialJitterBackoff(initialInterval, maxInterval time.Duration) requests.BackoffStrategy {
+	return func(attempt int) time.Duration {
+		delay := time.Duration(float64(initialInterval) * math.Pow(2, float64(attempt)))
+		if delay > maxInterval {
+			delay = maxInterval
+		}
+		jitter := time.Duration(mathrand.Int63n(int64(delay)/2 + 1)) //nolint:gosec // Jitter, not cryptography.
+		return delay - jitter
+	}
+}
+
+// Signature computes the standard-webhooks v1 signature for a payload:
+// HMAC-SHA256 over "<id>.<timestamp>.<payload>", base64-encoded with a "v1,"
+// prefix.
+func Signature(secret []byte, id string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s.%d.", id, timestamp.Unix())
+	mac.Write(payload)
+	return "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// newDeliveryID generates a random delivery identifier.
+func newDeliveryID() string {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "msg_" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return "msg_" + hex.EncodeToString(buf)
+}
+
+// Send marshals the payload to JSON, signs it, and delivers it to the URL
+// with retries. Failed deliveries are handed to the dead-letter callback
+// after the last attempt.
+func (s *Sender) Send(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	delivery := &Delivery{
+		ID:        newDeliveryID(),
+		URL:       url,
+		Timestamp: time.Now(),
+		Payload:   body,
+	}
+	return s.deliver(ctx, delivery)
+}
+
+// deliver executes the delivery attempts for a prepared payload.
+func (s *Sender) deliver(ctx context.Context, delivery *Delivery) error {
+	resp, err := s.client.Post(delivery.URL).
+		ContentType("application/json").
+		Header("webhook-id", delivery.ID).
+		Header("webhook-timestamp", strconv.FormatInt(delivery.Timestamp.Unix(), 10)).
+		Header("webhook-signature", Signature(s.secret, delivery.ID, delivery.Timestamp, delivery.Payload)).
+		Header("Idempotency-Key", delivery.ID).
+		Body(json.RawMessage(delivery.Payload)).
+		MaxRetries(s.maxRetries).
+		RetryStrategy(s.strategy).
+		RetryIf(requests.DefaultRetryIf).
+		Send(ctx)
+
+	if err == nil && !resp.IsSuccess() {
+		err = fmt.Errorf("webhook delivery failed with status %d", resp.StatusCode())
+	}
+	if resp != nil {
+		_ = resp.Close()
+	}
+
+	if err != nil {
+		if s.deadLetter != nil {
+			s.deadLetter(delivery, err)
+		}
+		return err
+	}
+	return nil
+}
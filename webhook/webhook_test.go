@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSenderSignsDeliveries(t *testing.T) {
+	secret := "whsec_test"
+
+	var received struct {
+		id        string
+		timestamp string
+		signature string
+		body      []byte
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.id = r.Header.Get("webhook-id")
+		received.timestamp = r.Header.Get("webhook-timestamp")
+		received.signature = r.Header.Get("webhook-signature")
+		received.body, _ = io.ReadAll(r.Body)
+		if r.Header.Get("Idempotency-Key") != received.id {
+			t.Errorf("Expected idempotency key to match webhook-id, got %q", r.Header.Get("Idempotency-Key"))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := NewSender(&Config{Secret: secret})
+	if err := sender.Send(context.Background(), server.URL, map[string]string{"event": "ping"}); err != nil {
+		t.Fatalf("Failed to send webhook: %v", err)
+	}
+
+	if received.id == "" || received.timestamp == "" {
+		t.Fatal("Expected webhook-id and webhook-timestamp headers")
+	}
+
+	// Recompute the signature the way a receiver would.
+	seconds, err := strconv.ParseInt(received.timestamp, 10, 64)
+	if err != nil {
+		t.Fatalf("Invalid timestamp header: %v", err)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s.%d.", received.id, seconds)
+	mac.Write(received.body)
+	expected := "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if received.signature != expected {
+		t.Errorf("Expected signature %q, got %q", expected, received.signature)
+	}
+	if string(received.body) != `{"event":"ping"}` {
+		t.Errorf("Unexpected payload: %s", received.body)
+	}
+}
+
+func TestSenderRetriesAndDeadLetters(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var deadLettered *Delivery
+	sender := NewSender(&Config{
+		Secret:        "whsec_test",
+		MaxRetries:    2,
+		RetryStrategy: func(attempt int) time.Duration { return time.Millisecond },
+		DeadLetter: func(delivery *Delivery, err error) {
+			deadLettered = delivery
+		},
+	})
+
+	err := sender.Send(context.Background(), server.URL, map[string]string{"event": "ping"})
+	if err == nil {
+		t.Fatal("Expected delivery to fail")
+	}
+	if got := atomic.LoadInt32(&hits); got != 3 {
+		t.Errorf("Expected 3 attempts, got %d", got)
+	}
+	if deadLettered == nil || deadLettered.ID == "" {
+		t.Fatal("Expected dead-letter callback with the delivery")
+	}
+}
+
+func TestExponentialJitterBackoff(t *testing.T) {
+	strategy := ExponentialJitterBackoff(time.Second, 4*time.Second)
+	for attempt := 0; attempt < 6; attempt++ {
+		delay := strategy(attempt)
+		if delay <= 0 || delay > 4*time.Second {
+			t.Errorf("Attempt %d: delay %v out of range", attempt, delay)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package requests
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// xmlrpcTimeFormat is the ISO 8601 variant used by XML-RPC dateTime values.
+const xmlrpcTimeFormat = "20060102T15:04:05"
+
+// XMLRPCFault is a typed XML-RPC fault returned by the server.
+type XMLRPCFault struct {
+	Code   int
+	String string
+}
+
+// Error implements the error interface.
+func (f *XMLRPCFault) Error() string {
+	return fmt.Sprintf("xmlrpc fault %d: %s", f.Code, f.String)
+}
+
+// XMLRPCCall performs an XML-RPC call against the path. Params may be bools,
+// integers, floats, strings, time.Time, []byte (base64), []interface{}
+// (array), and map[string]interface{} (struct); the decoded result uses the
+// same Go types. Server faults surface as *XMLRPCFault.
+func (c *Client) XMLRPCCall(ctx context.Context, path, method string, params []interface{}, result *interface{}) error {
+	var body strings.Builder
+	body.WriteString(xml.Header)
+	body.WriteString("<methodCall><methodName>")
+	if err := xml.EscapeText(&body, []byte(method)); err != nil {
+		return err
+	}
+	body.WriteString("</methodName><params>")
+	for _, param := range params {
+		body.WriteString("<param>")
+		if err := writeXMLRPCValue(&body, param); err != nil {
+			return err
+		}
+		body.WriteString("</param>")
+	}
+	body.WriteString("</params></methodCall>")
+
+	resp, err := c.Post(path).ContentType("text/xml").Body(body.String()).Send(ctx)
+	if err != nil {
+		return err
+	}
+	defer resp.Close() //nolint:errcheck
+
+	return decodeXMLRPCResponse(resp.Body(), result)
+}
+
+// writeXMLRPCValue encodes one Go value as an XML-RPC <value> element.
+func writeXMLRPCValue(w *strings.Builder, v interface{}) error {
+	w.WriteString("<value>")
+	switch value := v.(type) {
+	case nil:
+		w.WriteString("<nil/>")
+	case bool:
+		if value {
+			w.WriteString("<boolean>1</boolean>")
+		} else {
+			w.WriteString("<boolean>0</boolean>")
+		}
+	case int:
+		fmt.Fprintf(w, "<int>%d</int>", value)
+	case int32:
+		fmt.Fprintf(w, "<int>%d</int>", value)
+	case int64:
+		fmt.Fprintf(w, "<int>%d</int>", value)
+	case float32:
+		fmt.Fprintf(w, "<double>%g</double>", value)
+	case float64:
+		fmt.Fprintf(w, "<double>%g</double>", value)
+	case string:
+		w.WriteString("<string>")
+		if err := xml.EscapeText(w, []byte(value)); err != nil {
+			return err
+		}
+		w.WriteString("</string>")
+	case time.Time:
+		fmt.Fprintf(w, "<dateTime.iso8601>%s</dateTime.iso8601>", value.Format(xmlrpcTimeFormat))
+	case []byte:
+		fmt.Fprintf(w, "<base64>%s</base64>", base64.StdEncoding.EncodeToString(value))
+	case []interface{}:
+		w.WriteString("<array><data>")
+		for _, item := range value {
+			if err := writeXMLRPCValue(w, item); err != nil {
+				return err
+			}
+		}
+		w.WriteString("</data></array>")
+	case map[string]interface{}:
+		w.WriteString("<struct>")
+		for name, member := range value {
+			w.WriteString("<member><name>")
+			if err := xml.EscapeText(w, []byte(name)); err != nil {
+				return err
+			}
+			w.WriteString("</name>")
+			if err := writeXMLRPCValue(w, member); err != nil {
+				return err
+			}
+			w.WriteString("</member>")
+		}
+		w.WriteString("</struct>")
+	default:
+		return fmt.Errorf("%w: %T for xmlrpc param", ErrUnsupportedDataType, v)
+	}
+	w.WriteString("</value>")
+	return nil
+}
+
+// xmlrpcValue mirrors the XML-RPC <value> element for decoding.
+type xmlrpcValue struct {
+	Int      *string   `xml:"int"`
+	I4       *string   `xml:"i4"`
+	Boolean  *string   `xml:"boolean"`
+	String   *string   `xml:"string"`
+	Double   *string   `xml:"double"`
+	DateTime *string   `xml:"dateTime.iso8601"`
+	Base64   *string   `xml:"base64"`
+	Nil      *struct{} `xml:"nil"`
+	Array    *struct {
+		Values []xmlrpcValue `xml:"data>value"`
+	} `xml:"array"`
+	Struct *struct {
+		Members []struct {
+			Name  string      `xml:"name"`
+			Value xmlrpcValue `xml:"value"`
+		} `xml:"member"`
+	} `xml:"struct"`
+	Raw string `xml:",chardata"`
+}
+
+// xmlrpcResponse mirrors the XML-RPC <methodResponse> element.
+type xmlrpcResponse struct {
+	XMLName xml.Name      `xml:"methodResponse"`
+	Params  []xmlrpcValue `xml:"params>param>value"`
+	Fault   *xmlrpcValue  `xml:"fault>value"`
+}
+
+// decodeXMLRPCResponse parses a methodResponse, surfacing faults as errors.
+func decodeXMLRPCResponse(data []byte, result *interface{}) error {
+	var envelope xmlrpcResponse
+	if err := xml.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	if envelope.Fault != nil {
+		decoded, err := envelope.Fault.decode()
+		if err != nil {
+			return err
+		}
+		fault := &XMLRPCFault{}
+		if members, ok := decoded.(map[string]interface{}); ok {
+			if code, ok := members["faultCode"].(int); ok {
+				fault.Code = code
+			}
+			if text, ok := members["faultString"].(string); ok {
+				fault.String = text
+			}
+		}
+		return fault
+	}
+
+	if result == nil || len(envelope.Params) == 0 {
+		return nil
+	}
+	decoded, err := envelope.Params[0].decode()
+	if err != nil {
+		return err
+	}
+	*result = decoded
+	return nil
+}
+
+// decode converts a parsed <value> element into its Go representation.
+func (v *xmlrpcValue) decode() (interface{}, error) {
+	switch {
+	case v.Nil != nil:
+		return nil, nil
+	case v.Int != nil:
+		return strconv.Atoi(strings.TrimSpace(*v.Int))
+	case v.I4 != nil:
+		return strconv.Atoi(strings.TrimSpace(*v.I4))
+	case v.Boolean != nil:
+		return strings.TrimSpace(*v.Boolean) == "1", nil
+	case v.Double != nil:
+		return strconv.ParseFloat(strings.TrimSpace(*v.Double), 64)
+	case v.String != nil:
+		return *v.String, nil
+	case v.DateTime != nil:
+		return time.Parse(xmlrpcTimeFormat, strings.TrimSpace(*v.DateTime))
+	case v.Base64 != nil:
+		return base64.StdEncoding.DecodeString(strings.TrimSpace(*v.Base64))
+	case v.Array != nil:
+		items := make([]interface{}, 0, len(v.Array.Values))
+		for i := range v.Array.Values {
+			item, err := v.Array.Values[i].decode()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case v.Struct != nil:
+		members := make(map[string]interface{}, len(v.Struct.Members))
+		for i := range v.Struct.Members {
+			member, err := v.Struct.Members[i].Value.decode()
+			if err != nil {
+				return nil, err
+			}
+			members[v.Struct.Members[i].Name] = member
+		}
+		return members, nil
+	default:
+		// A bare <value> without a type element is a string per the spec.
+		return v.Raw, nil
+	}
+}